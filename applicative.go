@@ -0,0 +1,250 @@
+/*
+ * Copyright (c) 2020 Łukasz Szpakowski
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in
+ * all copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+ * THE SOFTWARE.
+ */
+
+package gofun
+
+// Applicative is the interface for applicative functors.
+type Applicative interface {
+    Functor
+    // Ap applies the functions from Applicative to the elements from xs. The functions must be
+    // func(interface{}) interface{}. If xs has other type, Ap returns an empty or failure
+    // Applicative.
+    Ap(xs Applicative) Applicative
+}
+
+// ApplicativeOrElse returns x if x is Applicative, otherwise y.
+func ApplicativeOrElse(x interface{}, y Applicative) Applicative {
+    z, isOk := x.(Applicative)
+    if isOk {
+        return z
+    } else {
+        return y
+    }
+}
+
+// LiftA2 lifts a function of two arguments to Applicatives. For InterfacePairMap, f takes two
+// pairs and must return a pair.
+func LiftA2(f func(interface{}, interface{}) interface{}, xs, ys Applicative) Applicative {
+    return curryMap(xs, func(x interface{}) interface{} {
+            return func(y interface{}) interface{} {
+                return f(x, y)
+            }
+    }).Ap(ys)
+}
+
+// LiftA3 lifts a function of three arguments to Applicatives. For InterfacePairMap, f takes three
+// pairs and must return a pair.
+func LiftA3(f func(interface{}, interface{}, interface{}) interface{}, xs, ys, zs Applicative) Applicative {
+    return LiftA2(func(x, y interface{}) interface{} {
+            return func(z interface{}) interface{} {
+                return f(x, y, z)
+            }
+    }, xs, ys).Ap(zs)
+}
+
+// curryMap maps the elements of xs to the functions. Unlike the Map method, curryMap maps the
+// pairs of InterfacePairMap to the values of InterfacePairMap.
+func curryMap(xs Applicative, f func(interface{}) interface{}) Applicative {
+    xs2, isOk := xs.(InterfacePairMap)
+    if isOk {
+        ys := make(map[interface{}]interface{}, len(xs2))
+        for k, v := range xs2 {
+            ys[k] = f(NewPair(k, v))
+        }
+        return InterfacePairMap(ys)
+    } else {
+        return ApplicativeOrElse(xs.Map(f), xs)
+    }
+}
+
+func applyFunction(f, x interface{}) (interface{}, bool) {
+    g, isOk := f.(func(interface{}) interface{})
+    if isOk {
+        return g(x), true
+    } else {
+        return nil, false
+    }
+}
+
+func (fs *Option) Ap(xs Applicative) Applicative {
+    xs2, isOk := xs.(*Option)
+    if isOk && fs.IsSome() && xs2.IsSome() {
+        y, isOk2 := applyFunction(fs.Get(), xs2.Get())
+        if isOk2 {
+            return Some(y)
+        } else {
+            return None()
+        }
+    } else {
+        return None()
+    }
+}
+
+// OptionPure is a pure function for Option.
+func OptionPure(x interface{}) Applicative {
+    return Some(x)
+}
+
+func (fs *Either) Ap(xs Applicative) Applicative {
+    xs2, isOk := xs.(*Either)
+    if isOk {
+        if fs.IsLeft() {
+            return fs
+        } else if xs2.IsLeft() {
+            return xs2
+        } else {
+            y, isOk2 := applyFunction(fs.GetRight(), xs2.GetRight())
+            if isOk2 {
+                return Right(y)
+            } else {
+                return Left(nil)
+            }
+        }
+    } else {
+        return Left(nil)
+    }
+}
+
+// EitherPure is a pure function for Either.
+func EitherPure(x interface{}) Applicative {
+    return Right(x)
+}
+
+func (fs *List) Ap(xs Applicative) Applicative {
+    var ys *List = Nil()
+    var prev *List = nil
+    xs2, isOk := xs.(*List)
+    if isOk {
+        for l := fs; l.IsCons(); l = l.Tail() {
+            for l2 := xs2; l2.IsCons(); l2 = l2.Tail() {
+                y, isOk2 := applyFunction(l.Head(), l2.Head())
+                if isOk2 {
+                    l3 := Cons(y, Nil())
+                    if prev != nil {
+                        prev.SetTail(l3)
+                    } else {
+                        ys = l3
+                    }
+                    prev = l3
+                }
+            }
+        }
+    }
+    return ys
+}
+
+// ListPure is a pure function for List.
+func ListPure(x interface{}) Applicative {
+    return Cons(x, Nil())
+}
+
+func (fs ST) Ap(xs Applicative) Applicative {
+    xs2, isOk := xs.(ST)
+    if isOk {
+        return ApplicativeOrElse(fs.Bind(func(f interface{}) Monad {
+                return MonadOrElse(xs2.Map(func(x interface{}) interface{} {
+                        y, _ := applyFunction(f, x)
+                        return y
+                }), xs2)
+        }), fs)
+    } else {
+        return fs
+    }
+}
+
+// STPure is a pure function for ST.
+func STPure(x interface{}) Applicative {
+    return STUnit(x).(ST)
+}
+
+func (fs InterfaceSlice) Ap(xs Applicative) Applicative {
+    xs2, isOk := xs.(InterfaceSlice)
+    if isOk {
+        ys := make([]interface{}, 0, len(fs) * len(xs2))
+        for _, f := range fs {
+            for _, x := range xs2 {
+                y, isOk2 := applyFunction(f, x)
+                if isOk2 {
+                    ys = append(ys, y)
+                }
+            }
+        }
+        return InterfaceSlice(ys)
+    } else {
+        return InterfaceSlice([]interface{} {})
+    }
+}
+
+// InterfaceSlicePure is a pure function for InterfaceSlice.
+func InterfaceSlicePure(x interface{}) Applicative {
+    return InterfaceSlice([]interface{} { x })
+}
+
+// Ap applies the functions that are the values of fs to the pairs of xs. The functions must return
+// pairs. The keys of the returned pairs are compared by the Equal function.
+func (fs InterfacePairMap) Ap(xs Applicative) Applicative {
+    ys := make(map[interface{}]interface{})
+    ks := newEqualKeys(nil)
+    xs2, isOk := xs.(InterfacePairMap)
+    if isOk {
+        for _, f := range fs {
+            for k, v := range xs2 {
+                y, isOk2 := applyFunction(f, NewPair(k, v))
+                if isOk2 {
+                    p, isOk3 := y.(*Pair)
+                    if isOk3 {
+                        ys[ks.key(p.First)] = p.Second
+                    }
+                }
+            }
+        }
+    }
+    return InterfacePairMap(ys)
+}
+
+// InterfacePairMapPure is a pure function for InterfacePairMap.
+func InterfacePairMapPure(x interface{}) Applicative {
+    return InterfacePairMapUnit(x).(InterfacePairMap)
+}
+
+func (fs InterfacePairFunction) Ap(xs Applicative) Applicative {
+    xs2, isOk := xs.(InterfacePairFunction)
+    if isOk {
+        return InterfacePairFunction(func(x interface{}) interface{} {
+                y, isOk2 := applyFunction(fs(x), xs2(x))
+                if isOk2 {
+                    return y
+                } else {
+                    return x
+                }
+        })
+    } else {
+        return InterfacePairFunction(func(x interface{}) interface{} {
+                return x
+        })
+    }
+}
+
+// InterfacePairFunctionPure is a pure function for InterfacePairFunction.
+func InterfacePairFunctionPure(x interface{}) Applicative {
+    return InterfacePairFunctionUnit(x).(InterfacePairFunction)
+}
@@ -0,0 +1,181 @@
+/*
+ * Copyright (c) 2020 Łukasz Szpakowski
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in
+ * all copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+ * THE SOFTWARE.
+ */
+
+package gofun_test
+import (
+    "reflect"
+    "testing"
+    . "gofun"
+)
+
+func inc(x interface{}) interface{} {
+    return IntOrElse(x, 0) + 1
+}
+
+func add(x, y interface{}) interface{} {
+    return IntOrElse(x, 0) + IntOrElse(y, 0)
+}
+
+func TestApMethodAppliesSome(t *testing.T) {
+    xs := Some(inc).Ap(Some(2))
+    if !reflect.DeepEqual(xs, Some(3)) {
+        t.Errorf("Ap method result is %v; want %v", xs, Some(3))
+    }
+}
+
+func TestApMethodAppliesNone(t *testing.T) {
+    xs := OptionPure(inc).Ap(None())
+    if !reflect.DeepEqual(xs, None()) {
+        t.Errorf("Ap method result is %v; want %v", xs, None())
+    }
+}
+
+func TestApMethodAppliesLeft(t *testing.T) {
+    xs := EitherPure(inc).Ap(Left("error"))
+    if !reflect.DeepEqual(xs, Left("error")) {
+        t.Errorf("Ap method result is %v; want %v", xs, Left("error"))
+    }
+}
+
+func TestApMethodAppliesRight(t *testing.T) {
+    xs := EitherPure(inc).Ap(Right(2))
+    if !reflect.DeepEqual(xs, Right(3)) {
+        t.Errorf("Ap method result is %v; want %v", xs, Right(3))
+    }
+}
+
+func TestApMethodAppliesList(t *testing.T) {
+    f := func(x interface{}) interface{} { return IntOrElse(x, 0) * 10 }
+    xs := Cons(inc, Cons(f, Nil())).Ap(Cons(1, Cons(2, Nil())))
+    if !reflect.DeepEqual(xs, Cons(2, Cons(3, Cons(10, Cons(20, Nil()))))) {
+        t.Errorf("Ap method result is %v; want %v", xs, Cons(2, Cons(3, Cons(10, Cons(20, Nil())))))
+    }
+}
+
+func TestApMethodAppliesST(t *testing.T) {
+    xs := STPure(inc).Ap(GetST().Bind(func(s interface{}) Monad {
+            return SetST(IntOrElse(s, 0) + 1).Map(func(x interface{}) interface{} {
+                    return s
+            }).(Monad)
+    }).(ST))
+    l, isOk := xs.(ST)
+    if !isOk {
+        t.Errorf("Ap method result type isn't ST")
+    } else {
+        s, x := RunST(l, 2)
+        if !reflect.DeepEqual(s, 3) {
+            t.Errorf("RunST function first result from Ap method result is %v; want %v", s, 3)
+        }
+        if !reflect.DeepEqual(x, 3) {
+            t.Errorf("RunST function second result from Ap method result is %v; want %v", x, 3)
+        }
+    }
+}
+
+func TestApMethodAppliesInterfaceSlice(t *testing.T) {
+    xs := InterfaceSlicePure(inc).Ap(InterfaceSlice([]interface{} { 1, 2 }))
+    if !reflect.DeepEqual(xs, InterfaceSlice([]interface{} { 2, 3 })) {
+        t.Errorf("Ap method result is %v; want %v", xs, InterfaceSlice([]interface{} { 2, 3 }))
+    }
+}
+
+func TestApMethodAppliesInterfacePairMap(t *testing.T) {
+    f := func(x interface{}) interface{} {
+        p := PairOrElse(x, NewPair("", 0))
+        return NewPair(StringOrElse(p.First, "") + "x", IntOrElse(p.Second, 0) + 1)
+    }
+    xs := InterfacePairMap(map[interface{}]interface{} { "f": f }).Ap(InterfacePairMap(map[interface{}]interface{} { "a": 1, "b": 2 }))
+    if !reflect.DeepEqual(xs, InterfacePairMap(map[interface{}]interface{} { "ax": 2, "bx": 3 })) {
+        t.Errorf("Ap method result is %v; want %v", xs, InterfacePairMap(map[interface{}]interface{} { "ax": 2, "bx": 3 }))
+    }
+}
+
+func TestApMethodMergesEqualPairKeysOfInterfacePairMap(t *testing.T) {
+    f := func(x interface{}) interface{} {
+        p := PairOrElse(x, NewPair("", 0))
+        return NewPair(NewPair(p.Second, 0), p.Second)
+    }
+    xs := InterfacePairMapOrElse(InterfacePairMap(map[interface{}]interface{} { "f": f }).Ap(InterfacePairMap(map[interface{}]interface{} { "a": 1, "b": 1 })), nil)
+    if len(xs) != 1 {
+        t.Errorf("length of Ap method result is %v; want %v", len(xs), 1)
+    }
+}
+
+func TestApMethodAppliesInterfacePairFunction(t *testing.T) {
+    xs := InterfacePairFunction(func(x interface{}) interface{} {
+            return func(y interface{}) interface{} {
+                return IntOrElse(x, 0) * IntOrElse(y, 0)
+            }
+    }).Ap(InterfacePairFunction(inc))
+    l, isOk := xs.(InterfacePairFunction)
+    if !isOk {
+        t.Errorf("Ap method result type isn't InterfacePairFunction")
+    } else {
+        y := l(3)
+        if !reflect.DeepEqual(y, 12) {
+            t.Errorf("function result of Ap method result is %v; want %v", y, 12)
+        }
+    }
+}
+
+func TestLiftA2FunctionLiftsSome(t *testing.T) {
+    xs := LiftA2(add, Some(2), Some(3))
+    if !reflect.DeepEqual(xs, Some(5)) {
+        t.Errorf("LiftA2 function result is %v; want %v", xs, Some(5))
+    }
+}
+
+func TestLiftA2FunctionLiftsInterfaceSlice(t *testing.T) {
+    xs := LiftA2(add, InterfaceSlice([]interface{} { 1, 2 }), InterfaceSlice([]interface{} { 10, 20 }))
+    if !reflect.DeepEqual(xs, InterfaceSlice([]interface{} { 11, 21, 12, 22 })) {
+        t.Errorf("LiftA2 function result is %v; want %v", xs, InterfaceSlice([]interface{} { 11, 21, 12, 22 }))
+    }
+}
+
+func TestLiftA2FunctionLiftsInterfacePairMap(t *testing.T) {
+    xs := LiftA2(func(x, y interface{}) interface{} {
+            p := PairOrElse(x, NewPair("", 0))
+            p2 := PairOrElse(y, NewPair("", 0))
+            return NewPair(StringOrElse(p.First, "") + StringOrElse(p2.First, ""), IntOrElse(p.Second, 0) + IntOrElse(p2.Second, 0))
+    }, InterfacePairMap(map[interface{}]interface{} { "a": 1, "b": 2 }), InterfacePairMap(map[interface{}]interface{} { "c": 3 }))
+    if !reflect.DeepEqual(xs, InterfacePairMap(map[interface{}]interface{} { "ac": 4, "bc": 5 })) {
+        t.Errorf("LiftA2 function result is %v; want %v", xs, InterfacePairMap(map[interface{}]interface{} { "ac": 4, "bc": 5 }))
+    }
+}
+
+func TestLiftA3FunctionLiftsRight(t *testing.T) {
+    xs := LiftA3(func(x, y, z interface{}) interface{} {
+            return IntOrElse(x, 0) + IntOrElse(y, 0) * IntOrElse(z, 0)
+    }, Right(1), Right(2), Right(3))
+    if !reflect.DeepEqual(xs, Right(7)) {
+        t.Errorf("LiftA3 function result is %v; want %v", xs, Right(7))
+    }
+}
+
+func TestLiftA3FunctionLiftsLeft(t *testing.T) {
+    xs := LiftA3(func(x, y, z interface{}) interface{} {
+            return IntOrElse(x, 0) + IntOrElse(y, 0) * IntOrElse(z, 0)
+    }, Right(1), Left("error"), Right(3))
+    if !reflect.DeepEqual(xs, Left("error")) {
+        t.Errorf("LiftA3 function result is %v; want %v", xs, Left("error"))
+    }
+}
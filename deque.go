@@ -0,0 +1,149 @@
+/*
+ * Copyright (c) 2020 Łukasz Szpakowski
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in
+ * all copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+ * THE SOFTWARE.
+ */
+
+package gofun
+import "fmt"
+
+// dequeBalance is the maximal ratio of the lengths of the streams of Deque.
+const dequeBalance = 3
+
+// Deque represents persistent double-ended queues. Deque is a banker's deque that has a lazy front
+// stream and a lazy rear stream. When one stream becomes too long in comparison with the other
+// stream, the elements are lazily moved between the streams, so the operations on both ends take
+// amortized O(1) time.
+type Deque struct {
+    front *Stream
+    frontLen int
+    rear *Stream
+    rearLen int
+}
+
+// DequeOrElse returns x if x is Deque pointer, otherwise y.
+func DequeOrElse(x interface{}, y *Deque) *Deque {
+    return OrElse(x, y)
+}
+
+// NewDeque creates an empty deque.
+func NewDeque() *Deque {
+    return &Deque { front: EmptyStream(), frontLen: 0, rear: EmptyStream(), rearLen: 0 }
+}
+
+// ToDeque creates a deque from the elements of xs.
+func ToDeque(xs Foldable) *Deque {
+    return DequeOrElse(xs.FoldLeft(func(x, y interface{}) interface{} {
+            return DequeOrElse(x, NewDeque()).PushBack(y)
+    }, NewDeque()), NewDeque())
+}
+
+func newDeque(front *Stream, frontLen int, rear *Stream, rearLen int) *Deque {
+    n := frontLen + rearLen
+    if frontLen > dequeBalance * rearLen + 1 {
+        i := n / 2
+        rear2 := rear.concat(func() *Stream {
+                return reverseStream(front.Drop(i))
+        })
+        return &Deque { front: front.Take(i), frontLen: i, rear: rear2, rearLen: n - i }
+    } else if rearLen > dequeBalance * frontLen + 1 {
+        i := n / 2
+        front2 := front.concat(func() *Stream {
+                return reverseStream(rear.Drop(i))
+        })
+        return &Deque { front: front2, frontLen: n - i, rear: rear.Take(i), rearLen: i }
+    } else {
+        return &Deque { front: front, frontLen: frontLen, rear: rear, rearLen: rearLen }
+    }
+}
+
+// Len returns the number of elements.
+func (d *Deque) Len() int {
+    return d.frontLen + d.rearLen
+}
+
+// IsEmpty returns true if the deque is empty, otherwise false.
+func (d *Deque) IsEmpty() bool {
+    return d.frontLen + d.rearLen == 0
+}
+
+// PushFront returns the deque with the element at the front.
+func (d *Deque) PushFront(x interface{}) *Deque {
+    return newDeque(consStream(x, d.front), d.frontLen + 1, d.rear, d.rearLen)
+}
+
+// PushBack returns the deque with the element at the back.
+func (d *Deque) PushBack(x interface{}) *Deque {
+    return newDeque(d.front, d.frontLen, consStream(x, d.rear), d.rearLen + 1)
+}
+
+// Front returns the optional first element.
+func (d *Deque) Front() *Option {
+    if d.frontLen > 0 {
+        return d.front.HeadOption()
+    } else {
+        return d.rear.HeadOption()
+    }
+}
+
+// Back returns the optional last element.
+func (d *Deque) Back() *Option {
+    if d.rearLen > 0 {
+        return d.rear.HeadOption()
+    } else {
+        return d.front.HeadOption()
+    }
+}
+
+// PopFront returns the optional pair of the first element and the deque of the rest elements.
+func (d *Deque) PopFront() *Option {
+    if d.frontLen > 0 {
+        return Some(NewPair(d.front.Head(), newDeque(d.front.Tail(), d.frontLen - 1, d.rear, d.rearLen)))
+    } else if d.rearLen > 0 {
+        return Some(NewPair(d.rear.Head(), NewDeque()))
+    } else {
+        return None()
+    }
+}
+
+// PopBack returns the optional pair of the deque of the rest elements and the last element.
+func (d *Deque) PopBack() *Option {
+    if d.rearLen > 0 {
+        return Some(NewPair(newDeque(d.front, d.frontLen, d.rear.Tail(), d.rearLen - 1), d.rear.Head()))
+    } else if d.frontLen > 0 {
+        return Some(NewPair(NewDeque(), d.front.Head()))
+    } else {
+        return None()
+    }
+}
+
+func (d *Deque) String() string {
+    s := "Deque["
+    isFirst := true
+    d.FoldLeft(func(x, y interface{}) interface{} {
+            if !isFirst {
+                s += " "
+            }
+            s += fmt.Sprintf("%v", y)
+            isFirst = false
+            return x
+    }, nil)
+    s += "]"
+    return s
+}
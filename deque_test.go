@@ -0,0 +1,127 @@
+/*
+ * Copyright (c) 2020 Łukasz Szpakowski
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in
+ * all copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+ * THE SOFTWARE.
+ */
+
+package gofun_test
+import (
+    "math/rand"
+    "reflect"
+    "testing"
+    . "gofun"
+)
+
+func TestDequeMethodsBehaveLikeSlice(t *testing.T) {
+    r := rand.New(rand.NewSource(1))
+    d := NewDeque()
+    xs := []interface{} {}
+    for i := 0; i < 5000; i++ {
+        switch r.Intn(4) {
+        case 0:
+            d = d.PushFront(i)
+            xs = append([]interface{} { i }, xs...)
+        case 1:
+            d = d.PushBack(i)
+            xs = append(xs, i)
+        case 2:
+            o := d.PopFront()
+            if len(xs) == 0 {
+                if !reflect.DeepEqual(o, None()) {
+                    t.Fatalf("Deque.PopFront method result is %v; want %v", o, None())
+                }
+            } else {
+                p := PairOrElse(o.Get(), nil)
+                if p == nil || !reflect.DeepEqual(p.First, xs[0]) {
+                    t.Fatalf("Deque.PopFront method result is %v; want pair with %v", o, xs[0])
+                }
+                d = DequeOrElse(p.Second, nil)
+                xs = xs[1:]
+            }
+        case 3:
+            o := d.PopBack()
+            if len(xs) == 0 {
+                if !reflect.DeepEqual(o, None()) {
+                    t.Fatalf("Deque.PopBack method result is %v; want %v", o, None())
+                }
+            } else {
+                p := PairOrElse(o.Get(), nil)
+                if p == nil || !reflect.DeepEqual(p.Second, xs[len(xs) - 1]) {
+                    t.Fatalf("Deque.PopBack method result is %v; want pair with %v", o, xs[len(xs) - 1])
+                }
+                d = DequeOrElse(p.First, nil)
+                xs = xs[:len(xs) - 1]
+            }
+        }
+        if d.Len() != len(xs) {
+            t.Fatalf("Deque.Len method result is %v; want %v", d.Len(), len(xs))
+        }
+    }
+    if !reflect.DeepEqual(ToSlice(d), InterfaceSlice(xs)) {
+        t.Errorf("Deque.FoldLeft method result is %v; want %v", d, InterfaceSlice(xs))
+    }
+    if !reflect.DeepEqual(ToList(d), ToList(InterfaceSlice(xs))) {
+        t.Errorf("Deque.FoldRight method result is %v; want %v", ToList(d), ToList(InterfaceSlice(xs)))
+    }
+}
+
+func TestDequeFrontMethodAndBackMethodReturnElements(t *testing.T) {
+    d := NewDeque().PushFront(1)
+    if !reflect.DeepEqual(d.Front(), Some(1)) {
+        t.Errorf("Deque.Front method result is %v; want %v", d.Front(), Some(1))
+    }
+    if !reflect.DeepEqual(d.Back(), Some(1)) {
+        t.Errorf("Deque.Back method result is %v; want %v", d.Back(), Some(1))
+    }
+    d2 := d.PushBack(2).PushFront(0)
+    if !reflect.DeepEqual(d2.Front(), Some(0)) {
+        t.Errorf("Deque.Front method result is %v; want %v", d2.Front(), Some(0))
+    }
+    if !reflect.DeepEqual(d2.Back(), Some(2)) {
+        t.Errorf("Deque.Back method result is %v; want %v", d2.Back(), Some(2))
+    }
+    if !reflect.DeepEqual(NewDeque().Front(), None()) {
+        t.Errorf("Deque.Front method result is %v; want %v", NewDeque().Front(), None())
+    }
+}
+
+func TestDequeMapMethodMapsElements(t *testing.T) {
+    d := DequeOrElse(ToDeque(InterfaceSlice([]interface{} { 1, 2, 3 })).PushFront(0).Map(inc), nil)
+    if !reflect.DeepEqual(ToSlice(d), InterfaceSlice([]interface{} { 1, 2, 3, 4 })) {
+        t.Errorf("Deque.Map method result is %v; want %v", d, InterfaceSlice([]interface{} { 1, 2, 3, 4 }))
+    }
+}
+
+func TestDequeBindMethodBindsElements(t *testing.T) {
+    d := ToDeque(InterfaceSlice([]interface{} { 1, 2 })).Bind(func(x interface{}) Monad {
+            return DequeUnit(x).(*Deque).PushFront(IntOrElse(x, 0) * 10)
+    })
+    if !reflect.DeepEqual(ToSlice(FoldableOrElse(d, nil)), InterfaceSlice([]interface{} { 10, 1, 20, 2 })) {
+        t.Errorf("Deque.Bind method result is %v; want %v", d, InterfaceSlice([]interface{} { 10, 1, 20, 2 }))
+    }
+}
+
+func TestFindFunctionFindsElementInDeque(t *testing.T) {
+    o := Find(func(x interface{}) bool {
+            return IntOrElse(x, 0) > 2
+    }, ToDeque(InterfaceSlice([]interface{} { 1, 2, 3, 4 })).PushFront(0))
+    if !reflect.DeepEqual(o, Some(3)) {
+        t.Errorf("Find function result is %v; want %v", o, Some(3))
+    }
+}
@@ -0,0 +1,196 @@
+/*
+ * Copyright (c) 2020 Łukasz Szpakowski
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in
+ * all copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+ * THE SOFTWARE.
+ */
+
+package gofun
+import "reflect"
+
+// Eq is the interface for values that are compared by their structure instead of their identity.
+type Eq interface {
+    // Equal returns true if the value is equal to other, otherwise false.
+    Equal(other interface{}) bool
+}
+
+// EqOrElse returns x if x is Eq, otherwise y.
+func EqOrElse(x interface{}, y Eq) Eq {
+    z, isOk := x.(Eq)
+    if isOk {
+        return z
+    } else {
+        return y
+    }
+}
+
+// Equal returns true if x is equal to y, otherwise false. If x or y is Eq, Equal uses the Equal
+// method; otherwise Equal uses equal operator for comparable values and returns false for other
+// values.
+func Equal(x, y interface{}) bool {
+    x2, isOk := x.(Eq)
+    if isOk {
+        return x2.Equal(y)
+    }
+    y2, isOk2 := y.(Eq)
+    if isOk2 {
+        return y2.Equal(x)
+    }
+    if x == nil || y == nil {
+        return x == y
+    }
+    t := reflect.TypeOf(x)
+    if t != reflect.TypeOf(y) || !t.Comparable() {
+        return false
+    }
+    return x == y
+}
+
+func (p *Pair) Equal(other interface{}) bool {
+    p2, isOk := other.(*Pair)
+    if isOk {
+        if p == nil || p2 == nil {
+            return p == p2
+        }
+        return Equal(p.First, p2.First) && Equal(p.Second, p2.Second)
+    } else {
+        return false
+    }
+}
+
+func (o *Option) Equal(other interface{}) bool {
+    o2, isOk := other.(*Option)
+    if isOk {
+        if o == nil || o2 == nil {
+            return o == o2
+        }
+        if o.isSome && o2.isSome {
+            return Equal(o.x, o2.x)
+        } else {
+            return o.isSome == o2.isSome
+        }
+    } else {
+        return false
+    }
+}
+
+func (e *Either) Equal(other interface{}) bool {
+    e2, isOk := other.(*Either)
+    if isOk {
+        if e == nil || e2 == nil {
+            return e == e2
+        }
+        return e.isRight == e2.isRight && Equal(e.x, e2.x)
+    } else {
+        return false
+    }
+}
+
+func (l *List) Equal(other interface{}) bool {
+    l2, isOk := other.(*List)
+    if isOk {
+        if l == nil || l2 == nil {
+            return l == l2
+        }
+        for l3, l4 := l, l2; ; l3, l4 = l3.tail, l4.tail {
+            if l3 == l4 {
+                return true
+            }
+            if !l3.isCons || !l4.isCons {
+                return l3.isCons == l4.isCons
+            }
+            if !Equal(l3.head, l4.head) {
+                return false
+            }
+        }
+    } else {
+        return false
+    }
+}
+
+func (xs InterfaceSlice) Equal(other interface{}) bool {
+    ys, isOk := other.(InterfaceSlice)
+    if isOk {
+        if len(xs) != len(ys) {
+            return false
+        }
+        for i := range xs {
+            if !Equal(xs[i], ys[i]) {
+                return false
+            }
+        }
+        return true
+    } else {
+        return false
+    }
+}
+
+func (xs InterfacePairMap) Equal(other interface{}) bool {
+    ys, isOk := other.(InterfacePairMap)
+    if isOk {
+        if len(xs) != len(ys) {
+            return false
+        }
+        ks := newEqualKeys(ys)
+        for k, v := range xs {
+            k2, isOk2 := ks.find(k)
+            if !isOk2 || !Equal(v, ys[k2]) {
+                return false
+            }
+        }
+        return true
+    } else {
+        return false
+    }
+}
+
+// equalKeys groups the keys of a map by the Hash function and compares them by the Equal function,
+// like HashPairMap compares its keys.
+type equalKeys map[uint64][]interface{}
+
+// newEqualKeys creates equalKeys with the keys of xs.
+func newEqualKeys(xs map[interface{}]interface{}) equalKeys {
+    ks := make(equalKeys, len(xs))
+    for k := range xs {
+        h := Hash(k)
+        ks[h] = append(ks[h], k)
+    }
+    return ks
+}
+
+// find returns the key that is equal to k.
+func (ks equalKeys) find(k interface{}) (interface{}, bool) {
+    for _, k2 := range ks[Hash(k)] {
+        if Equal(k, k2) {
+            return k2, true
+        }
+    }
+    return nil, false
+}
+
+// key returns the key that is equal to k or adds k if there isn't such key.
+func (ks equalKeys) key(k interface{}) interface{} {
+    k2, isOk := ks.find(k)
+    if isOk {
+        return k2
+    } else {
+        h := Hash(k)
+        ks[h] = append(ks[h], k)
+        return k
+    }
+}
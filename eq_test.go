@@ -0,0 +1,128 @@
+/*
+ * Copyright (c) 2020 Łukasz Szpakowski
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in
+ * all copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+ * THE SOFTWARE.
+ */
+
+package gofun_test
+import (
+    "testing"
+    . "gofun"
+)
+
+func TestEqualFunctionComparesPairs(t *testing.T) {
+    b := Equal(NewPair(1, "a"), NewPair(1, "a"))
+    if b != true {
+        t.Errorf("Equal function result is %v; want %v", b, true)
+    }
+}
+
+func TestEqualFunctionComparesDifferentPairs(t *testing.T) {
+    b := Equal(NewPair(1, "a"), NewPair(1, "b"))
+    if b != false {
+        t.Errorf("Equal function result is %v; want %v", b, false)
+    }
+}
+
+func TestEqualFunctionComparesNestedValues(t *testing.T) {
+    x := Some(Cons(NewPair(1, 2), Nil()))
+    y := Some(Cons(NewPair(1, 2), Nil()))
+    b := Equal(x, y)
+    if b != true {
+        t.Errorf("Equal function result is %v; want %v", b, true)
+    }
+}
+
+func TestEqualFunctionComparesLeftAndRight(t *testing.T) {
+    b := Equal(Left(1), Right(1))
+    if b != false {
+        t.Errorf("Equal function result is %v; want %v", b, false)
+    }
+}
+
+func TestEqualFunctionComparesListsWithDifferentLengths(t *testing.T) {
+    b := Equal(Cons(1, Cons(2, Nil())), Cons(1, Nil()))
+    if b != false {
+        t.Errorf("Equal function result is %v; want %v", b, false)
+    }
+}
+
+func TestEqualFunctionComparesInterfaceSlices(t *testing.T) {
+    b := Equal(InterfaceSlice([]interface{} { NewPair(1, 2), 3 }), InterfaceSlice([]interface{} { NewPair(1, 2), 3 }))
+    if b != true {
+        t.Errorf("Equal function result is %v; want %v", b, true)
+    }
+}
+
+func TestEqualFunctionComparesInterfacePairMaps(t *testing.T) {
+    b := Equal(InterfacePairMap(map[interface{}]interface{} { "a": NewPair(1, 2) }), InterfacePairMap(map[interface{}]interface{} { "a": NewPair(1, 2) }))
+    if b != true {
+        t.Errorf("Equal function result is %v; want %v", b, true)
+    }
+}
+
+func TestEqualFunctionComparesUncomparableValues(t *testing.T) {
+    b := Equal([]int { 1 }, []int { 1 })
+    if b != false {
+        t.Errorf("Equal function result is %v; want %v", b, false)
+    }
+}
+
+func TestEqualFunctionComparesInterfacePairMapsWithPairKeys(t *testing.T) {
+    b := Equal(InterfacePairMap(map[interface{}]interface{} { NewPair(1, 2): "a" }), InterfacePairMap(map[interface{}]interface{} { NewPair(1, 2): "a" }))
+    if b != true {
+        t.Errorf("Equal function result is %v; want %v", b, true)
+    }
+}
+
+func TestEqualFunctionComparesNilPairs(t *testing.T) {
+    var p *Pair = nil
+    b := Equal(p, p)
+    if b != true {
+        t.Errorf("Equal function result is %v; want %v", b, true)
+    }
+    b2 := Equal(p, NewPair(1, 2))
+    if b2 != false {
+        t.Errorf("Equal function result is %v; want %v", b2, false)
+    }
+    b3 := Equal(NewPair(1, 2), p)
+    if b3 != false {
+        t.Errorf("Equal function result is %v; want %v", b3, false)
+    }
+}
+
+func TestEqualFunctionComparesNilOptionAndList(t *testing.T) {
+    var o *Option = nil
+    b := Equal(None(), o)
+    if b != false {
+        t.Errorf("Equal function result is %v; want %v", b, false)
+    }
+    var l *List = nil
+    b2 := Equal(l, Nil())
+    if b2 != false {
+        t.Errorf("Equal function result is %v; want %v", b2, false)
+    }
+}
+
+func TestNotElementFunctionComparesPairsByStructure(t *testing.T) {
+    b := NotElement(NewPair(1, 2), InterfaceSlice([]interface{} { NewPair(1, 2), NewPair(3, 4) }))
+    if b != false {
+        t.Errorf("NotElement function result is %v; want %v", b, false)
+    }
+}
@@ -0,0 +1,481 @@
+/*
+ * Copyright (c) 2020 Łukasz Szpakowski
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in
+ * all copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+ * THE SOFTWARE.
+ */
+
+package gofun
+import "fmt"
+
+// fingerNode is a node with two or three children in the middle trees of a finger tree. The node
+// caches the measure of its children.
+type fingerNode struct {
+    v interface{}
+    children []interface{}
+}
+
+// fingerDeep is an internal finger tree. The nil pointer is an empty tree, a tree with isSingle
+// contains one element, and other trees have the prefix and the suffix of one to four elements and
+// the middle tree of fingerNode pointers. The elements of the middle trees are fingerNode
+// pointers. The deep tree caches its measure.
+type fingerDeep struct {
+    v interface{}
+    isSingle bool
+    x interface{}
+    prefix []interface{}
+    middle *fingerDeep
+    suffix []interface{}
+}
+
+// FingerTree represents persistent sequences that are measured by a monoid. The measure of the
+// sequence is the monoid sum of the measures of the elements, so FingerTree can be an indexed
+// sequence, a priority queue, or other structure. Access to both ends takes amortized O(1) time and
+// Concat and Split take O(log n) time. Map, Zip and Unzip keep the monoid and the measure function
+// of the tree, so use MapMeasured or ZipMeasured if the new elements need another measure.
+type FingerTree struct {
+    m Monoid
+    measure func(interface{}) interface{}
+    root *fingerDeep
+}
+
+// FingerTreeOrElse returns x if x is FingerTree pointer, otherwise y.
+func FingerTreeOrElse(x interface{}, y *FingerTree) *FingerTree {
+    return OrElse(x, y)
+}
+
+// NewFingerTree creates an empty finger tree with the monoid and the function that measures the
+// elements.
+func NewFingerTree(m Monoid, measure func(interface{}) interface{}) *FingerTree {
+    return &FingerTree { m: m, measure: measure, root: nil }
+}
+
+// ToFingerTree creates a finger tree with the monoid and the function that measures the elements
+// from the elements of xs.
+func ToFingerTree(m Monoid, measure func(interface{}) interface{}, xs Foldable) *FingerTree {
+    return FingerTreeOrElse(xs.FoldLeft(func(x, y interface{}) interface{} {
+            return FingerTreeOrElse(x, nil).PushBack(y)
+    }, NewFingerTree(m, measure)), NewFingerTree(m, measure))
+}
+
+func (t *FingerTree) withRoot(root *fingerDeep) *FingerTree {
+    return &FingerTree { m: t.m, measure: t.measure, root: root }
+}
+
+func (t *FingerTree) measureOf(x interface{}) interface{} {
+    node, isOk := x.(*fingerNode)
+    if isOk {
+        return node.v
+    } else {
+        return t.measure(x)
+    }
+}
+
+func (t *FingerTree) measureDigits(xs []interface{}) interface{} {
+    y := t.m.Empty()
+    for _, x := range xs {
+        y = t.m.Append(y, t.measureOf(x))
+    }
+    return y
+}
+
+func (t *FingerTree) measureDeep(d *fingerDeep) interface{} {
+    if d == nil {
+        return t.m.Empty()
+    } else if d.isSingle {
+        return t.measureOf(d.x)
+    } else {
+        return d.v
+    }
+}
+
+func (t *FingerTree) newNode(xs ...interface{}) *fingerNode {
+    return &fingerNode { v: t.measureDigits(xs), children: xs }
+}
+
+func (t *FingerTree) newSingle(x interface{}) *fingerDeep {
+    return &fingerDeep { isSingle: true, x: x }
+}
+
+func (t *FingerTree) newDeep(prefix []interface{}, middle *fingerDeep, suffix []interface{}) *fingerDeep {
+    v := t.m.Append(t.m.Append(t.measureDigits(prefix), t.measureDeep(middle)), t.measureDigits(suffix))
+    return &fingerDeep { v: v, prefix: prefix, middle: middle, suffix: suffix }
+}
+
+func fingerDigits(xs ...interface{}) []interface{} {
+    return xs
+}
+
+func (t *FingerTree) pushFront(d *fingerDeep, x interface{}) *fingerDeep {
+    if d == nil {
+        return t.newSingle(x)
+    } else if d.isSingle {
+        return t.newDeep(fingerDigits(x), nil, fingerDigits(d.x))
+    } else if len(d.prefix) == 4 {
+        node := t.newNode(d.prefix[1], d.prefix[2], d.prefix[3])
+        return t.newDeep(fingerDigits(x, d.prefix[0]), t.pushFront(d.middle, node), d.suffix)
+    } else {
+        return t.newDeep(append(fingerDigits(x), d.prefix...), d.middle, d.suffix)
+    }
+}
+
+func (t *FingerTree) pushBack(d *fingerDeep, x interface{}) *fingerDeep {
+    if d == nil {
+        return t.newSingle(x)
+    } else if d.isSingle {
+        return t.newDeep(fingerDigits(d.x), nil, fingerDigits(x))
+    } else if len(d.suffix) == 4 {
+        node := t.newNode(d.suffix[0], d.suffix[1], d.suffix[2])
+        return t.newDeep(d.prefix, t.pushBack(d.middle, node), fingerDigits(d.suffix[3], x))
+    } else {
+        return t.newDeep(d.prefix, d.middle, append(append(fingerDigits(), d.suffix...), x))
+    }
+}
+
+func (t *FingerTree) digitsToDeep(xs []interface{}) *fingerDeep {
+    var d *fingerDeep = nil
+    for _, x := range xs {
+        d = t.pushBack(d, x)
+    }
+    return d
+}
+
+// popFront returns the first element and the rest of the tree if the tree isn't empty.
+func (t *FingerTree) popFront(d *fingerDeep) (interface{}, *fingerDeep, bool) {
+    if d == nil {
+        return nil, nil, false
+    } else if d.isSingle {
+        return d.x, nil, true
+    } else {
+        return d.prefix[0], t.deepLeft(d.prefix[1:], d.middle, d.suffix), true
+    }
+}
+
+// popBack returns the rest of the tree and the last element if the tree isn't empty.
+func (t *FingerTree) popBack(d *fingerDeep) (*fingerDeep, interface{}, bool) {
+    if d == nil {
+        return nil, nil, false
+    } else if d.isSingle {
+        return nil, d.x, true
+    } else {
+        return t.deepRight(d.prefix, d.middle, d.suffix[:len(d.suffix) - 1]), d.suffix[len(d.suffix) - 1], true
+    }
+}
+
+// deepLeft creates a deep tree where the prefix can be empty.
+func (t *FingerTree) deepLeft(prefix []interface{}, middle *fingerDeep, suffix []interface{}) *fingerDeep {
+    if len(prefix) > 0 {
+        return t.newDeep(prefix, middle, suffix)
+    }
+    x, middle2, isOk := t.popFront(middle)
+    if isOk {
+        return t.newDeep(x.(*fingerNode).children, middle2, suffix)
+    } else {
+        return t.digitsToDeep(suffix)
+    }
+}
+
+// deepRight creates a deep tree where the suffix can be empty.
+func (t *FingerTree) deepRight(prefix []interface{}, middle *fingerDeep, suffix []interface{}) *fingerDeep {
+    if len(suffix) > 0 {
+        return t.newDeep(prefix, middle, suffix)
+    }
+    middle2, x, isOk := t.popBack(middle)
+    if isOk {
+        return t.newDeep(prefix, middle2, x.(*fingerNode).children)
+    } else {
+        return t.digitsToDeep(prefix)
+    }
+}
+
+func (t *FingerTree) nodes(xs []interface{}) []interface{} {
+    ys := make([]interface{}, 0, len(xs) / 2)
+    for len(xs) > 0 {
+        switch len(xs) {
+        case 2:
+            ys = append(ys, t.newNode(xs[0], xs[1]))
+            xs = xs[2:]
+        case 4:
+            ys = append(ys, t.newNode(xs[0], xs[1]), t.newNode(xs[2], xs[3]))
+            xs = xs[4:]
+        default:
+            ys = append(ys, t.newNode(xs[0], xs[1], xs[2]))
+            xs = xs[3:]
+        }
+    }
+    return ys
+}
+
+// concat concatenates two trees with the elements between them.
+func (t *FingerTree) concat(d *fingerDeep, xs []interface{}, d2 *fingerDeep) *fingerDeep {
+    if d == nil {
+        for i := len(xs) - 1; i >= 0; i-- {
+            d2 = t.pushFront(d2, xs[i])
+        }
+        return d2
+    } else if d2 == nil {
+        for _, x := range xs {
+            d = t.pushBack(d, x)
+        }
+        return d
+    } else if d.isSingle {
+        return t.pushFront(t.concat(nil, xs, d2), d.x)
+    } else if d2.isSingle {
+        return t.pushBack(t.concat(d, xs, nil), d2.x)
+    } else {
+        ys := make([]interface{}, 0, len(d.suffix) + len(xs) + len(d2.prefix))
+        ys = append(append(append(ys, d.suffix...), xs...), d2.prefix...)
+        return t.newDeep(d.prefix, t.concat(d.middle, t.nodes(ys), d2.middle), d2.suffix)
+    }
+}
+
+func (t *FingerTree) splitDigits(p func(interface{}) bool, v interface{}, xs []interface{}) ([]interface{}, interface{}, []interface{}) {
+    for i, x := range xs {
+        v = t.m.Append(v, t.measureOf(x))
+        if p(v) || i == len(xs) - 1 {
+            return xs[:i], x, xs[i + 1:]
+        }
+    }
+    return nil, nil, nil
+}
+
+// split splits the non-empty tree at the first element where p becomes true for the sum of v and
+// the measures to this element.
+func (t *FingerTree) split(p func(interface{}) bool, v interface{}, d *fingerDeep) (*fingerDeep, interface{}, *fingerDeep) {
+    if d.isSingle {
+        return nil, d.x, nil
+    }
+    v2 := t.m.Append(v, t.measureDigits(d.prefix))
+    if p(v2) {
+        l, x, r := t.splitDigits(p, v, d.prefix)
+        return t.digitsToDeep(l), x, t.deepLeft(r, d.middle, d.suffix)
+    }
+    v3 := t.m.Append(v2, t.measureDeep(d.middle))
+    if d.middle != nil && p(v3) {
+        ml, node, mr := t.split(p, v2, d.middle)
+        l, x, r := t.splitDigits(p, t.m.Append(v2, t.measureDeep(ml)), node.(*fingerNode).children)
+        return t.deepRight(d.prefix, ml, l), x, t.deepLeft(r, mr, d.suffix)
+    }
+    l, x, r := t.splitDigits(p, v3, d.suffix)
+    return t.deepRight(d.prefix, d.middle, l), x, t.digitsToDeep(r)
+}
+
+// each calls f for the elements of the tree or the node in order while f returns true. If f
+// returns false, each also returns false.
+func fingerEach(x interface{}, f func(interface{}) bool) bool {
+    switch x2 := x.(type) {
+    case *fingerNode:
+        for _, y := range x2.children {
+            if !fingerEach(y, f) {
+                return false
+            }
+        }
+        return true
+    case *fingerDeep:
+        if x2 == nil {
+            return true
+        } else if x2.isSingle {
+            return fingerEach(x2.x, f)
+        }
+        for _, y := range x2.prefix {
+            if !fingerEach(y, f) {
+                return false
+            }
+        }
+        if !fingerEach(x2.middle, f) {
+            return false
+        }
+        for _, y := range x2.suffix {
+            if !fingerEach(y, f) {
+                return false
+            }
+        }
+        return true
+    default:
+        return f(x)
+    }
+}
+
+// fingerEachReverse is similar to fingerEach but calls f in reverse order.
+func fingerEachReverse(x interface{}, f func(interface{}) bool) bool {
+    switch x2 := x.(type) {
+    case *fingerNode:
+        for i := len(x2.children) - 1; i >= 0; i-- {
+            if !fingerEachReverse(x2.children[i], f) {
+                return false
+            }
+        }
+        return true
+    case *fingerDeep:
+        if x2 == nil {
+            return true
+        } else if x2.isSingle {
+            return fingerEachReverse(x2.x, f)
+        }
+        for i := len(x2.suffix) - 1; i >= 0; i-- {
+            if !fingerEachReverse(x2.suffix[i], f) {
+                return false
+            }
+        }
+        if !fingerEachReverse(x2.middle, f) {
+            return false
+        }
+        for i := len(x2.prefix) - 1; i >= 0; i-- {
+            if !fingerEachReverse(x2.prefix[i], f) {
+                return false
+            }
+        }
+        return true
+    default:
+        return f(x)
+    }
+}
+
+// IsEmpty returns true if the tree is empty, otherwise false.
+func (t *FingerTree) IsEmpty() bool {
+    return t.root == nil
+}
+
+// Measure returns the measure of the tree.
+func (t *FingerTree) Measure() interface{} {
+    return t.measureDeep(t.root)
+}
+
+// PushFront returns the tree with the element at the front.
+func (t *FingerTree) PushFront(x interface{}) *FingerTree {
+    return t.withRoot(t.pushFront(t.root, x))
+}
+
+// PushBack returns the tree with the element at the back.
+func (t *FingerTree) PushBack(x interface{}) *FingerTree {
+    return t.withRoot(t.pushBack(t.root, x))
+}
+
+// Front returns the optional first element.
+func (t *FingerTree) Front() *Option {
+    if t.root == nil {
+        return None()
+    } else if t.root.isSingle {
+        return Some(t.root.x)
+    } else {
+        return Some(t.root.prefix[0])
+    }
+}
+
+// Back returns the optional last element.
+func (t *FingerTree) Back() *Option {
+    if t.root == nil {
+        return None()
+    } else if t.root.isSingle {
+        return Some(t.root.x)
+    } else {
+        return Some(t.root.suffix[len(t.root.suffix) - 1])
+    }
+}
+
+// PopFront returns the optional pair of the first element and the tree of the rest elements.
+func (t *FingerTree) PopFront() *Option {
+    x, d, isOk := t.popFront(t.root)
+    if isOk {
+        return Some(NewPair(x, t.withRoot(d)))
+    } else {
+        return None()
+    }
+}
+
+// PopBack returns the optional pair of the tree of the rest elements and the last element.
+func (t *FingerTree) PopBack() *Option {
+    d, x, isOk := t.popBack(t.root)
+    if isOk {
+        return Some(NewPair(t.withRoot(d), x))
+    } else {
+        return None()
+    }
+}
+
+// Concat concatenates two trees. The result has the monoid and the measure function of xs.
+func (xs *FingerTree) Concat(ys *FingerTree) *FingerTree {
+    return xs.withRoot(xs.concat(xs.root, nil, ys.root))
+}
+
+// Split splits the tree into two trees where the second tree starts from the first element for
+// which p returns true for the measure of the elements to this element. P should be monotonic, so
+// if p returns true for a measure, p returns true for the greater measures. If p returns false for
+// the measure of the tree, the second tree is empty.
+func (t *FingerTree) Split(p func(interface{}) bool) (*FingerTree, *FingerTree) {
+    if t.root != nil && p(t.measureDeep(t.root)) {
+        l, x, r := t.split(p, t.m.Empty(), t.root)
+        return t.withRoot(l), t.withRoot(t.pushFront(r, x))
+    } else {
+        return t, t.withRoot(nil)
+    }
+}
+
+// Lookup returns the optional first element for which p returns true for the measure of the
+// elements to this element. P should be monotonic like for Split.
+func (t *FingerTree) Lookup(p func(interface{}) bool) *Option {
+    if t.root != nil && p(t.measureDeep(t.root)) {
+        _, x, _ := t.split(p, t.m.Empty(), t.root)
+        return Some(x)
+    } else {
+        return None()
+    }
+}
+
+// MapMeasured maps the elements by f to the tree with the monoid and the function that measures
+// the mapped elements.
+func (t *FingerTree) MapMeasured(f func(interface{}) interface{}, m Monoid, measure func(interface{}) interface{}) *FingerTree {
+    t2 := NewFingerTree(m, measure)
+    fingerEach(t.root, func(x interface{}) bool {
+            t2 = t2.PushBack(f(x))
+            return true
+    })
+    return t2
+}
+
+// ZipMeasured zips the elements of two trees into pairs to the tree with the monoid and the
+// function that measures the pairs. The result has the length of the shorter tree.
+func (xs *FingerTree) ZipMeasured(ys *FingerTree, m Monoid, measure func(interface{}) interface{}) *FingerTree {
+    zs := NewFingerTree(m, measure)
+    ys2 := ToSlice(ys)
+    i := 0
+    fingerEach(xs.root, func(x interface{}) bool {
+            if i >= len(ys2) {
+                return false
+            }
+            zs = zs.PushBack(NewPair(x, ys2[i]))
+            i++
+            return true
+    })
+    return zs
+}
+
+func (t *FingerTree) String() string {
+    s := "FingerTree["
+    isFirst := true
+    fingerEach(t.root, func(x interface{}) bool {
+            if !isFirst {
+                s += " "
+            }
+            s += fmt.Sprintf("%v", x)
+            isFirst = false
+            return true
+    })
+    s += "]"
+    return s
+}
@@ -0,0 +1,228 @@
+/*
+ * Copyright (c) 2020 Łukasz Szpakowski
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in
+ * all copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+ * THE SOFTWARE.
+ */
+
+package gofun_test
+import (
+    "reflect"
+    "testing"
+    . "gofun"
+)
+
+func newIndexedSeq() *FingerTree {
+    return NewFingerTree(SumMonoid[int](), func(x interface{}) interface{} {
+            return 1
+    })
+}
+
+func rangeIndexedSeq(from, to int) *FingerTree {
+    t := newIndexedSeq()
+    for i := from; i < to; i++ {
+        t = t.PushBack(i)
+    }
+    return t
+}
+
+func rangeSlice(from, to int) InterfaceSlice {
+    xs := make([]interface{}, 0, to - from)
+    for i := from; i < to; i++ {
+        xs = append(xs, i)
+    }
+    return InterfaceSlice(xs)
+}
+
+func TestFingerTreePushBackMethodAndPushFrontMethodAddElements(t *testing.T) {
+    ft := newIndexedSeq()
+    for i := 0; i < 500; i++ {
+        ft = ft.PushBack(500 + i).PushFront(499 - i)
+    }
+    if !reflect.DeepEqual(ToSlice(ft), rangeSlice(0, 1000)) {
+        t.Errorf("FingerTree.PushBack method result is %v; want %v", ft, rangeSlice(0, 1000))
+    }
+    if !reflect.DeepEqual(ft.Measure(), 1000) {
+        t.Errorf("FingerTree.Measure method result is %v; want %v", ft.Measure(), 1000)
+    }
+    if !reflect.DeepEqual(ft.Front(), Some(0)) {
+        t.Errorf("FingerTree.Front method result is %v; want %v", ft.Front(), Some(0))
+    }
+    if !reflect.DeepEqual(ft.Back(), Some(999)) {
+        t.Errorf("FingerTree.Back method result is %v; want %v", ft.Back(), Some(999))
+    }
+}
+
+func TestFingerTreePopFrontMethodRemovesElements(t *testing.T) {
+    ft := rangeIndexedSeq(0, 1000)
+    for i := 0; i < 1000; i++ {
+        p := PairOrElse(ft.PopFront().GetOrElse(func() interface{} {
+                return nil
+        }), nil)
+        if p == nil || !reflect.DeepEqual(p.First, i) {
+            t.Fatalf("FingerTree.PopFront method result is %v; want pair with %v", p, i)
+        }
+        ft = FingerTreeOrElse(p.Second, nil)
+        if !reflect.DeepEqual(ft.Measure(), 999 - i) {
+            t.Fatalf("FingerTree.Measure method result is %v; want %v", ft.Measure(), 999 - i)
+        }
+    }
+    if !reflect.DeepEqual(ft.PopFront(), None()) {
+        t.Errorf("FingerTree.PopFront method result is %v; want %v", ft.PopFront(), None())
+    }
+}
+
+func TestFingerTreePopBackMethodRemovesElements(t *testing.T) {
+    ft := rangeIndexedSeq(0, 1000)
+    for i := 999; i >= 0; i-- {
+        p := PairOrElse(ft.PopBack().GetOrElse(func() interface{} {
+                return nil
+        }), nil)
+        if p == nil || !reflect.DeepEqual(p.Second, i) {
+            t.Fatalf("FingerTree.PopBack method result is %v; want pair with %v", p, i)
+        }
+        ft = FingerTreeOrElse(p.First, nil)
+    }
+    if ft.IsEmpty() != true {
+        t.Errorf("FingerTree.IsEmpty method result is %v; want %v", ft.IsEmpty(), true)
+    }
+}
+
+func TestFingerTreeConcatMethodConcatenatesTrees(t *testing.T) {
+    for _, n := range []int { 0, 1, 5, 20, 300 } {
+        for _, m := range []int { 0, 1, 7, 100 } {
+            ft := rangeIndexedSeq(0, n).Concat(rangeIndexedSeq(n, n + m))
+            if !reflect.DeepEqual(ToSlice(ft), rangeSlice(0, n + m)) {
+                t.Errorf("FingerTree.Concat method result is %v; want %v", ft, rangeSlice(0, n + m))
+            }
+            if !reflect.DeepEqual(ft.Measure(), n + m) {
+                t.Errorf("FingerTree.Measure method result is %v; want %v", ft.Measure(), n + m)
+            }
+        }
+    }
+}
+
+func TestFingerTreeSplitMethodSplitsTreeAtIndex(t *testing.T) {
+    ft := rangeIndexedSeq(0, 300)
+    for _, i := range []int { 0, 1, 4, 5, 150, 299 } {
+        l, r := ft.Split(func(x interface{}) bool {
+                return IntOrElse(x, 0) > i
+        })
+        if !reflect.DeepEqual(ToSlice(l), rangeSlice(0, i)) {
+            t.Errorf("FingerTree.Split method first result is %v; want %v", l, rangeSlice(0, i))
+        }
+        if !reflect.DeepEqual(ToSlice(r), rangeSlice(i, 300)) {
+            t.Errorf("FingerTree.Split method second result is %v; want %v", r, rangeSlice(i, 300))
+        }
+    }
+    l, r := ft.Split(func(x interface{}) bool {
+            return IntOrElse(x, 0) > 300
+    })
+    if !reflect.DeepEqual(ToSlice(l), rangeSlice(0, 300)) || r.IsEmpty() != true {
+        t.Errorf("FingerTree.Split method results are %v and %v; want %v and %v", l, r, rangeSlice(0, 300), rangeSlice(0, 0))
+    }
+}
+
+func TestFingerTreeLookupMethodFindsMaximalElement(t *testing.T) {
+    ft := ToFingerTree(MaxMonoid(), func(x interface{}) interface{} {
+            return Some(x)
+    }, InterfaceSlice([]interface{} { 3, 1, 4, 1, 5, 9, 2, 6 }))
+    o := ft.Lookup(func(x interface{}) bool {
+            return Equal(x, ft.Measure())
+    })
+    if !reflect.DeepEqual(o, Some(9)) {
+        t.Errorf("FingerTree.Lookup method result is %v; want %v", o, Some(9))
+    }
+}
+
+func TestFingerTreeFoldRightMethodFoldsElements(t *testing.T) {
+    l := ToList(rangeIndexedSeq(0, 100))
+    if !reflect.DeepEqual(l, ToList(rangeSlice(0, 100))) {
+        t.Errorf("FingerTree.FoldRight method result is %v; want %v", l, ToList(rangeSlice(0, 100)))
+    }
+}
+
+func TestFingerTreeMapMethodMapsElements(t *testing.T) {
+    ft := FingerTreeOrElse(rangeIndexedSeq(0, 100).Map(inc), nil)
+    if !reflect.DeepEqual(ToSlice(ft), rangeSlice(1, 101)) {
+        t.Errorf("FingerTree.Map method result is %v; want %v", ft, rangeSlice(1, 101))
+    }
+    if !reflect.DeepEqual(ft.Measure(), 100) {
+        t.Errorf("FingerTree.Measure method result is %v; want %v", ft.Measure(), 100)
+    }
+}
+
+func TestFingerTreeSplitMethodSplitsMappedTree(t *testing.T) {
+    ft := FingerTreeOrElse(rangeIndexedSeq(0, 100).Map(inc), nil)
+    l, r := ft.Split(func(x interface{}) bool { return IntOrElse(x, 0) > 40 })
+    if !reflect.DeepEqual(ToSlice(l), rangeSlice(1, 41)) {
+        t.Errorf("FingerTree.Split method first result is %v; want %v", l, rangeSlice(1, 41))
+    }
+    if !reflect.DeepEqual(ToSlice(r), rangeSlice(41, 101)) {
+        t.Errorf("FingerTree.Split method second result is %v; want %v", r, rangeSlice(41, 101))
+    }
+}
+
+func TestFingerTreeMapMeasuredMethodMeasuresMappedElements(t *testing.T) {
+    ft := rangeIndexedSeq(0, 10).MapMeasured(func(x interface{}) interface{} {
+            return NewPair(x, IntOrElse(x, 0) * 2)
+    }, SumMonoid[int](), func(x interface{}) interface{} {
+            return IntOrElse(PairOrElse(x, NewPair(nil, 0)).Second, 0)
+    })
+    if !reflect.DeepEqual(ft.Measure(), 90) {
+        t.Errorf("FingerTree.Measure method result is %v; want %v", ft.Measure(), 90)
+    }
+    l, r := ft.Split(func(x interface{}) bool { return IntOrElse(x, 0) > 12 })
+    o := r.Front()
+    if !reflect.DeepEqual(o, Some(NewPair(4, 8))) {
+        t.Errorf("FingerTree.Front method result is %v; want %v", o, Some(NewPair(4, 8)))
+    }
+    if !reflect.DeepEqual(l.Measure(), 12) {
+        t.Errorf("FingerTree.Measure method result is %v; want %v", l.Measure(), 12)
+    }
+}
+
+func TestFingerTreeZipMeasuredMethodMeasuresPairs(t *testing.T) {
+    ft := rangeIndexedSeq(0, 5).ZipMeasured(rangeIndexedSeq(10, 20), SumMonoid[int](), func(x interface{}) interface{} {
+            return IntOrElse(PairOrElse(x, NewPair(nil, 0)).Second, 0)
+    })
+    if !reflect.DeepEqual(ft.Measure(), 60) {
+        t.Errorf("FingerTree.Measure method result is %v; want %v", ft.Measure(), 60)
+    }
+    o := ft.Lookup(func(x interface{}) bool { return IntOrElse(x, 0) > 30 })
+    if !reflect.DeepEqual(o, Some(NewPair(2, 12))) {
+        t.Errorf("FingerTree.Lookup method result is %v; want %v", o, Some(NewPair(2, 12)))
+    }
+}
+
+func TestFingerTreeZipMethodAndUnzipMethodZipAndUnzipElements(t *testing.T) {
+    ft := FingerTreeOrElse(rangeIndexedSeq(0, 3).Zip(ToFingerTree(SumMonoid[int](), func(x interface{}) interface{} {
+            return 1
+    }, InterfaceSlice([]interface{} { "a", "b" })), nil), nil)
+    ys := InterfaceSlice([]interface{} { NewPair(0, "a"), NewPair(1, "b") })
+    if !reflect.DeepEqual(ToSlice(ft), ys) {
+        t.Errorf("FingerTree.Zip method result is %v; want %v", ft, ys)
+    }
+    xs, xs2 := ft.Unzip(nil)
+    if !reflect.DeepEqual(ToSlice(FoldableOrElse(xs, nil)), rangeSlice(0, 2)) {
+        t.Errorf("FingerTree.Unzip method first result is %v; want %v", xs, rangeSlice(0, 2))
+    }
+    if !reflect.DeepEqual(ToSlice(FoldableOrElse(xs2, nil)), InterfaceSlice([]interface{} { "a", "b" })) {
+        t.Errorf("FingerTree.Unzip method second result is %v; want %v", xs2, InterfaceSlice([]interface{} { "a", "b" }))
+    }
+}
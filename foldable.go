@@ -33,6 +33,24 @@ type Foldable interface {
     FoldRight(f func(interface{}, interface{}) interface{}, z interface{}) interface{}
 }
 
+// FoldableWhile is the interface for folding that can be stopped before the end of Foldable.
+type FoldableWhile interface {
+    Foldable
+    // FoldLeftWhile is similar to FoldLeft but stops folding after the first call of f that
+    // returns false as the second result.
+    FoldLeftWhile(f func(interface{}, interface{}) (interface{}, bool), z interface{}) interface{}
+}
+
+// FoldableWhileOrElse returns x if x is FoldableWhile, otherwise y.
+func FoldableWhileOrElse(x interface{}, y FoldableWhile) FoldableWhile {
+    z, isOk := x.(FoldableWhile)
+    if isOk {
+        return z
+    } else {
+        return y
+    }
+}
+
 // FoldableOrElse returns x if x is Foldable, otherwise y.
 func FoldableOrElse(x interface{}, y Foldable) Foldable {
     z, isOk := x.(Foldable)
@@ -45,9 +63,10 @@ func FoldableOrElse(x interface{}, y Foldable) Foldable {
 
 // All returns true if f returns true for all elements, otherwise false.
 func All(f func(interface{}) bool, xs Foldable) bool {
-    return BoolOrElse(xs.FoldLeft(func(x, y interface{}) interface{} {
-            return BoolOrElse(x, false) && f(y)
-    }, true), false)
+    return BoolOrElse(FoldLeftWhile(func(x, y interface{}) (interface{}, bool) {
+            b := f(y)
+            return b, b
+    }, true, xs), false)
 }
 
 // AllM is similar to All but returns Monad and f returns Monad instead of bool values. Unit must be
@@ -64,9 +83,10 @@ func AllM(f func(interface{}) Monad, xs Foldable, unit func(interface{}) Monad)
 
 // Any returns true if f returns true for any element, otherwise false.
 func Any(f func(interface{}) bool, xs Foldable) bool {
-    return BoolOrElse(xs.FoldLeft(func(x, y interface{}) interface{} {
-            return BoolOrElse(x, false) || f(y)
-    }, false), false)
+    return BoolOrElse(FoldLeftWhile(func(x, y interface{}) (interface{}, bool) {
+            b := f(y)
+            return b, !b
+    }, false, xs), false)
 }
 
 // AnyM is similar to Any but returns Monad and f returns Monad instead of bool values. Unit must be
@@ -83,16 +103,17 @@ func AnyM(f func(interface{}) Monad, xs Foldable, unit func(interface{}) Monad)
 
 // DeepElement is similar to Element but uses reflect.DeepEqual instead of equal operator.
 func DeepElement(x interface{}, xs Foldable) bool {
-    return BoolOrElse(xs.FoldLeft(func(y, z interface{}) interface{} {
-            return BoolOrElse(y, false) || reflect.DeepEqual(z, x)
-    }, false), false)
+    return Any(func(y interface{}) bool {
+            return reflect.DeepEqual(y, x)
+    }, xs)
 }
 
-// Element returns true if Foldable contains the element, otherwise false. 
+// Element returns true if Foldable contains the element, otherwise false. Element compares the
+// elements by the Equal function, so Eq values are compared by their structure.
 func Element(x interface{}, xs Foldable) bool {
-    return BoolOrElse(xs.FoldLeft(func(y, z interface{}) interface{} {
-            return BoolOrElse(y, false) || z == x
-    }, false), false)
+    return Any(func(y interface{}) bool {
+            return Equal(x, y)
+    }, xs)
 }
 
 // Filter filters the elements and returns a list of the filtered elements.
@@ -168,18 +189,13 @@ func FilterSliceM(f func(interface{}) Monad, xs Foldable, unit func(interface{})
 
 // Find finds the element and returns the optional found element.
 func Find(f func(interface{}) bool, xs Foldable) *Option {
-    return OptionOrElse(xs.FoldLeft(func(x, y interface{}) interface{} {
-            o := OptionOrElse(x, None())
-            if o.IsSome() {
-                return o
+    return OptionOrElse(FoldLeftWhile(func(x, y interface{}) (interface{}, bool) {
+            if f(y) {
+                return Some(y), false
             } else {
-                if f(y) {
-                    return Some(y)
-                } else {
-                    return None()
-                }
+                return x, true
             }
-    }, None()), None())
+    }, None(), xs), None())
 }
 
 // FindM is similar to Find but returns Monad and f returns Monad instead of optional element and
@@ -202,8 +218,24 @@ func FindM(f func(interface{}) Monad, xs Foldable, unit func(interface{}) Monad)
 }
 
 // FoldLeftM is similar to FoldLeft but returns Monad and f returns Monad instead of a value. Unit
-// must be the unit function for specified monad.
+// must be the unit function for specified monad. If the monad is MonadRec, FoldLeftM doesn't grow
+// the stack.
 func FoldLeftM(f func(interface{}, interface{}) Monad, z interface{}, xs Foldable, unit func(interface{}) Monad) Monad {
+    m, isOk := unit(z).(MonadRec)
+    if isOk {
+        ys := ToSlice(xs)
+        return m.TailRecM(func(x interface{}) Monad {
+                p := PairOrElse(x, NewPair(len(ys), z))
+                i := IntOrElse(p.First, len(ys))
+                if i < len(ys) {
+                    return f(p.Second, ys[i]).Bind(func(y interface{}) Monad {
+                            return unit(Left(NewPair(i + 1, y)))
+                    })
+                } else {
+                    return unit(Right(p.Second))
+                }
+        }, NewPair(0, z))
+    }
     g, isOk := xs.FoldRight(func(y, x interface{}) interface{} {
         return func(x2 interface{}) Monad {
             h, isOk2 := x.(func(interface{}) Monad)
@@ -222,8 +254,24 @@ func FoldLeftM(f func(interface{}, interface{}) Monad, z interface{}, xs Foldabl
 }
 
 // FoldRightM is similar to FoldRight but returns Monad and f returns Monad instead of a value. Unit
-// must be the unit function for specified monad.
+// must be the unit function for specified monad. If the monad is MonadRec, FoldRightM doesn't grow
+// the stack.
 func FoldRightM(f func(interface{}, interface{}) Monad, z interface{}, xs Foldable, unit func(interface{}) Monad) Monad {
+    m, isOk := unit(z).(MonadRec)
+    if isOk {
+        ys := ToSlice(xs)
+        return m.TailRecM(func(x interface{}) Monad {
+                p := PairOrElse(x, NewPair(-1, z))
+                i := IntOrElse(p.First, -1)
+                if i >= 0 {
+                    return f(ys[i], p.Second).Bind(func(y interface{}) Monad {
+                            return unit(Left(NewPair(i - 1, y)))
+                    })
+                } else {
+                    return unit(Right(p.Second))
+                }
+        }, NewPair(len(ys) - 1, z))
+    }
     g, isOk := xs.FoldLeft(func(x, y interface{}) interface{} {
         return func(x2 interface{}) Monad {
             h, isOk2 := x.(func(interface{}) Monad)
@@ -241,6 +289,26 @@ func FoldRightM(f func(interface{}, interface{}) Monad, z interface{}, xs Foldab
     }
 }
 
+// FoldLeftWhile is similar to FoldLeft but stops folding after the first call of f that returns
+// false as the second result. If xs is FoldableWhile, FoldLeftWhile doesn't visit the rest of
+// elements; otherwise FoldLeftWhile ignores them.
+func FoldLeftWhile(f func(interface{}, interface{}) (interface{}, bool), z interface{}, xs Foldable) interface{} {
+    xs2, isOk := xs.(FoldableWhile)
+    if isOk {
+        return xs2.FoldLeftWhile(f, z)
+    } else {
+        isStopped := false
+        return xs.FoldLeft(func(x, y interface{}) interface{} {
+                if isStopped {
+                    return x
+                }
+                x2, isCont := f(x, y)
+                isStopped = !isCont
+                return x2
+        }, z)
+    }
+}
+
 // Length returns the length of Foldable.
 func Length(xs Foldable) int {
     return IntOrElse(xs.FoldLeft(func(x, y interface{}) interface{} {
@@ -260,9 +328,9 @@ func NotElement(x interface{}, xs Foldable) bool {
 
 // Null returns true if Foldable is empty, otherwise false.
 func Null(xs Foldable) bool {
-    return BoolOrElse(xs.FoldLeft(func (x, y interface{}) interface{} {
-            return false
-    }, true), false)
+    return BoolOrElse(FoldLeftWhile(func (x, y interface{}) (interface{}, bool) {
+            return false, false
+    }, true, xs), false)
 }
 
 // ToList converts Foldable to a list.
@@ -304,6 +372,15 @@ func (xs *Option) FoldRight(f func(interface{}, interface{}) interface{}, z inte
     }
 }
 
+func (xs *Option) FoldLeftWhile(f func(interface{}, interface{}) (interface{}, bool), z interface{}) interface{} {
+    if xs.IsSome() {
+        y, _ := f(z, xs.Get())
+        return y
+    } else {
+        return z
+    }
+}
+
 func (xs *Either) FoldLeft(f func(interface{}, interface{}) interface{}, z interface{}) interface{} {
     if xs.IsRight() {
         return f(z, xs.GetRight())
@@ -320,6 +397,15 @@ func (xs *Either) FoldRight(f func(interface{}, interface{}) interface{}, z inte
     }
 }
 
+func (xs *Either) FoldLeftWhile(f func(interface{}, interface{}) (interface{}, bool), z interface{}) interface{} {
+    if xs.IsRight() {
+        y, _ := f(z, xs.GetRight())
+        return y
+    } else {
+        return z
+    }
+}
+
 func (xs *List) FoldLeft(f func(interface{}, interface{}) interface{}, z interface{}) interface{} {
     y := z
     for l := xs; l.IsCons(); l = l.Tail() {
@@ -336,6 +422,18 @@ func (xs *List) FoldRight(f func(interface{}, interface{}) interface{}, z interf
     return InterfaceSlice(ys).FoldRight(f, z)
 }
 
+func (xs *List) FoldLeftWhile(f func(interface{}, interface{}) (interface{}, bool), z interface{}) interface{} {
+    y := z
+    for l := xs; l.IsCons(); l = l.Tail() {
+        var isCont bool
+        y, isCont = f(y, l.Head())
+        if !isCont {
+            break
+        }
+    }
+    return y
+}
+
 func (xs InterfaceSlice) FoldLeft(f func(interface{}, interface{}) interface{}, z interface{}) interface{} {
     y := z
     for _, x := range xs {
@@ -352,6 +450,18 @@ func (xs InterfaceSlice) FoldRight(f func(interface{}, interface{}) interface{},
     return y
 }
 
+func (xs InterfaceSlice) FoldLeftWhile(f func(interface{}, interface{}) (interface{}, bool), z interface{}) interface{} {
+    y := z
+    for _, x := range xs {
+        var isCont bool
+        y, isCont = f(y, x)
+        if !isCont {
+            break
+        }
+    }
+    return y
+}
+
 func (xs InterfacePairMap) FoldLeft(f func(interface{}, interface{}) interface{}, z interface{}) interface{} {
     y := z
     for k, v := range xs {
@@ -367,3 +477,299 @@ func (xs InterfacePairMap) FoldRight(f func(interface{}, interface{}) interface{
     }
     return InterfaceSlice(ys).FoldRight(f, z)
 }
+
+func (xs InterfacePairMap) FoldLeftWhile(f func(interface{}, interface{}) (interface{}, bool), z interface{}) interface{} {
+    y := z
+    for k, v := range xs {
+        var isCont bool
+        y, isCont = f(y, NewPair(k, v))
+        if !isCont {
+            break
+        }
+    }
+    return y
+}
+
+func (xs *HashPairMap) FoldLeft(f func(interface{}, interface{}) interface{}, z interface{}) interface{} {
+    y := z
+    for _, bucket := range xs.buckets {
+        for _, p := range bucket {
+            y = f(y, NewPair(p.First, p.Second))
+        }
+    }
+    return y
+}
+
+func (xs *HashPairMap) FoldRight(f func(interface{}, interface{}) interface{}, z interface{}) interface{} {
+    ys := make([]interface{}, 0, xs.size)
+    for _, bucket := range xs.buckets {
+        for _, p := range bucket {
+            ys = append(ys, NewPair(p.First, p.Second))
+        }
+    }
+    return InterfaceSlice(ys).FoldRight(f, z)
+}
+
+func (xs *HashPairMap) FoldLeftWhile(f func(interface{}, interface{}) (interface{}, bool), z interface{}) interface{} {
+    y := z
+    for _, bucket := range xs.buckets {
+        for _, p := range bucket {
+            var isCont bool
+            y, isCont = f(y, NewPair(p.First, p.Second))
+            if !isCont {
+                return y
+            }
+        }
+    }
+    return y
+}
+
+func (xs *Future) FoldLeft(f func(interface{}, interface{}) interface{}, z interface{}) interface{} {
+    <-xs.done
+    if xs.result.IsRight() {
+        return f(z, xs.result.GetRight())
+    } else {
+        return z
+    }
+}
+
+func (xs *Future) FoldRight(f func(interface{}, interface{}) interface{}, z interface{}) interface{} {
+    <-xs.done
+    if xs.result.IsRight() {
+        return f(xs.result.GetRight(), z)
+    } else {
+        return z
+    }
+}
+
+func (xs *Future) FoldLeftWhile(f func(interface{}, interface{}) (interface{}, bool), z interface{}) interface{} {
+    <-xs.done
+    if xs.result.IsRight() {
+        y, _ := f(z, xs.result.GetRight())
+        return y
+    } else {
+        return z
+    }
+}
+
+func (xs *Lazy) FoldLeft(f func(interface{}, interface{}) interface{}, z interface{}) interface{} {
+    return f(z, xs.Force())
+}
+
+func (xs *Lazy) FoldRight(f func(interface{}, interface{}) interface{}, z interface{}) interface{} {
+    return f(xs.Force(), z)
+}
+
+func (xs *Lazy) FoldLeftWhile(f func(interface{}, interface{}) (interface{}, bool), z interface{}) interface{} {
+    y, _ := f(z, xs.Force())
+    return y
+}
+
+func (xs *Stream) FoldLeft(f func(interface{}, interface{}) interface{}, z interface{}) interface{} {
+    y := z
+    for s := xs; s.isCons; s = s.Tail() {
+        y = f(y, s.head)
+    }
+    return y
+}
+
+func (xs *Stream) FoldRight(f func(interface{}, interface{}) interface{}, z interface{}) interface{} {
+    return ToSlice(xs).FoldRight(f, z)
+}
+
+func (xs *Stream) FoldLeftWhile(f func(interface{}, interface{}) (interface{}, bool), z interface{}) interface{} {
+    y := z
+    for s := xs; s.isCons; s = s.Tail() {
+        var isCont bool
+        y, isCont = f(y, s.head)
+        if !isCont {
+            return y
+        }
+    }
+    return y
+}
+
+func (xs *Vector) FoldLeft(f func(interface{}, interface{}) interface{}, z interface{}) interface{} {
+    y := z
+    xs.each(func(x interface{}) bool {
+            y = f(y, x)
+            return true
+    })
+    return y
+}
+
+func (xs *Vector) FoldRight(f func(interface{}, interface{}) interface{}, z interface{}) interface{} {
+    y := z
+    for i := xs.length - 1; i >= 0; i-- {
+        j := xs.offset + i
+        y = f(xs.leaf(j)[j & vectorMask], y)
+    }
+    return y
+}
+
+func (xs *Vector) FoldLeftWhile(f func(interface{}, interface{}) (interface{}, bool), z interface{}) interface{} {
+    y := z
+    xs.each(func(x interface{}) bool {
+            var isCont bool
+            y, isCont = f(y, x)
+            return isCont
+    })
+    return y
+}
+
+func (xs *HashMap) FoldLeft(f func(interface{}, interface{}) interface{}, z interface{}) interface{} {
+    y := z
+    xs.root.each(func(e *hashMapEntry) bool {
+            y = f(y, NewPair(e.key, e.value))
+            return true
+    })
+    return y
+}
+
+func (xs *HashMap) FoldRight(f func(interface{}, interface{}) interface{}, z interface{}) interface{} {
+    return ToSlice(xs).FoldRight(f, z)
+}
+
+func (xs *HashMap) FoldLeftWhile(f func(interface{}, interface{}) (interface{}, bool), z interface{}) interface{} {
+    y := z
+    xs.root.each(func(e *hashMapEntry) bool {
+            var isCont bool
+            y, isCont = f(y, NewPair(e.key, e.value))
+            return isCont
+    })
+    return y
+}
+
+func (xs *TreeMap) FoldLeft(f func(interface{}, interface{}) interface{}, z interface{}) interface{} {
+    y := z
+    treeEach(xs.root, func(node *treeNode) bool {
+            y = f(y, NewPair(node.key, node.value))
+            return true
+    })
+    return y
+}
+
+func (xs *TreeMap) FoldRight(f func(interface{}, interface{}) interface{}, z interface{}) interface{} {
+    y := z
+    treeEachReverse(xs.root, func(node *treeNode) bool {
+            y = f(NewPair(node.key, node.value), y)
+            return true
+    })
+    return y
+}
+
+func (xs *TreeMap) FoldLeftWhile(f func(interface{}, interface{}) (interface{}, bool), z interface{}) interface{} {
+    y := z
+    treeEach(xs.root, func(node *treeNode) bool {
+            var isCont bool
+            y, isCont = f(y, NewPair(node.key, node.value))
+            return isCont
+    })
+    return y
+}
+
+func (xs *TreeSet) FoldLeft(f func(interface{}, interface{}) interface{}, z interface{}) interface{} {
+    y := z
+    treeEach(xs.root, func(node *treeNode) bool {
+            y = f(y, node.key)
+            return true
+    })
+    return y
+}
+
+func (xs *TreeSet) FoldRight(f func(interface{}, interface{}) interface{}, z interface{}) interface{} {
+    y := z
+    treeEachReverse(xs.root, func(node *treeNode) bool {
+            y = f(node.key, y)
+            return true
+    })
+    return y
+}
+
+func (xs *TreeSet) FoldLeftWhile(f func(interface{}, interface{}) (interface{}, bool), z interface{}) interface{} {
+    y := z
+    treeEach(xs.root, func(node *treeNode) bool {
+            var isCont bool
+            y, isCont = f(y, node.key)
+            return isCont
+    })
+    return y
+}
+
+func (xs *FingerTree) FoldLeft(f func(interface{}, interface{}) interface{}, z interface{}) interface{} {
+    y := z
+    fingerEach(xs.root, func(x interface{}) bool {
+            y = f(y, x)
+            return true
+    })
+    return y
+}
+
+func (xs *FingerTree) FoldRight(f func(interface{}, interface{}) interface{}, z interface{}) interface{} {
+    y := z
+    fingerEachReverse(xs.root, func(x interface{}) bool {
+            y = f(x, y)
+            return true
+    })
+    return y
+}
+
+func (xs *FingerTree) FoldLeftWhile(f func(interface{}, interface{}) (interface{}, bool), z interface{}) interface{} {
+    y := z
+    fingerEach(xs.root, func(x interface{}) bool {
+            var isCont bool
+            y, isCont = f(y, x)
+            return isCont
+    })
+    return y
+}
+
+func (xs *Queue) FoldLeft(f func(interface{}, interface{}) interface{}, z interface{}) interface{} {
+    return reverseStream(xs.rear).FoldLeft(f, xs.front.FoldLeft(f, z))
+}
+
+func (xs *Queue) FoldRight(f func(interface{}, interface{}) interface{}, z interface{}) interface{} {
+    return xs.front.FoldRight(f, xs.rear.FoldLeft(func(x, y interface{}) interface{} {
+            return f(y, x)
+    }, z))
+}
+
+func (xs *Queue) FoldLeftWhile(f func(interface{}, interface{}) (interface{}, bool), z interface{}) interface{} {
+    isStopped := false
+    g := func(x, y interface{}) (interface{}, bool) {
+        var isCont bool
+        x, isCont = f(x, y)
+        isStopped = !isCont
+        return x, isCont
+    }
+    y := xs.front.FoldLeftWhile(g, z)
+    if isStopped {
+        return y
+    }
+    return reverseStream(xs.rear).FoldLeftWhile(g, y)
+}
+
+func (xs *Deque) FoldLeft(f func(interface{}, interface{}) interface{}, z interface{}) interface{} {
+    return reverseStream(xs.rear).FoldLeft(f, xs.front.FoldLeft(f, z))
+}
+
+func (xs *Deque) FoldRight(f func(interface{}, interface{}) interface{}, z interface{}) interface{} {
+    return xs.front.FoldRight(f, xs.rear.FoldLeft(func(x, y interface{}) interface{} {
+            return f(y, x)
+    }, z))
+}
+
+func (xs *Deque) FoldLeftWhile(f func(interface{}, interface{}) (interface{}, bool), z interface{}) interface{} {
+    isStopped := false
+    g := func(x, y interface{}) (interface{}, bool) {
+        var isCont bool
+        x, isCont = f(x, y)
+        isStopped = !isCont
+        return x, isCont
+    }
+    y := xs.front.FoldLeftWhile(g, z)
+    if isStopped {
+        return y
+    }
+    return reverseStream(xs.rear).FoldLeftWhile(g, y)
+}
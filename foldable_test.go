@@ -371,6 +371,13 @@ func TestElementFunctionDoesNotFindElement(t *testing.T) {
     }
 }
 
+func TestElementFunctionFindsEqualPair(t *testing.T) {
+    b := Element(NewPair(1, 2), Cons(NewPair(0, 1), Cons(NewPair(1, 2), Nil())))
+    if b != true {
+        t.Errorf("Element function result is %v; want %v", b, true)
+    }
+}
+
 func TestFilterFunctionFilters(t *testing.T) {
     xs := Filter(func(x interface{}) bool {
             return IntOrElse(x, 0) % 2 == 0
@@ -601,3 +608,70 @@ func TestToSliceFunctionReturnsSlice(t *testing.T) {
         t.Errorf("ToSlice function result is %v; want %v", xs, InterfaceSlice([]interface{} { 1, 2, 3 }))
     }
 }
+
+func TestFoldLeftWhileMethodFoldsListUntilFalse(t *testing.T) {
+    xs := Cons(1, Cons(2, Cons(3, Cons(4, Nil())))).FoldLeftWhile(func(x, y interface{}) (interface{}, bool) {
+            return append(InterfaceSliceOrElse(x, InterfaceSlice([]interface{} {})), y), IntOrElse(y, 0) < 2
+    }, InterfaceSlice([]interface{} {}))
+    if !reflect.DeepEqual(xs, InterfaceSlice([]interface{} { 1, 2 })) {
+        t.Errorf("FoldLeftWhile method result is %v; want %v", xs, InterfaceSlice([]interface{} { 1, 2 }))
+    }
+}
+
+func TestFoldLeftWhileMethodFoldsInterfaceSliceUntilFalse(t *testing.T) {
+    xs := InterfaceSlice([]interface{} { 1, 2, 3, 4 }).FoldLeftWhile(func(x, y interface{}) (interface{}, bool) {
+            return append(InterfaceSliceOrElse(x, InterfaceSlice([]interface{} {})), y), IntOrElse(y, 0) < 3
+    }, InterfaceSlice([]interface{} {}))
+    if !reflect.DeepEqual(xs, InterfaceSlice([]interface{} { 1, 2, 3 })) {
+        t.Errorf("FoldLeftWhile method result is %v; want %v", xs, InterfaceSlice([]interface{} { 1, 2, 3 }))
+    }
+}
+
+func TestFoldLeftWhileMethodFoldsInterfacePairMapUntilFalse(t *testing.T) {
+    n := InterfacePairMap(map[interface{}]interface{} { "a": 1, "b": 2, "c": 3 }).FoldLeftWhile(func(x, y interface{}) (interface{}, bool) {
+            return IntOrElse(x, 0) + 1, false
+    }, 0)
+    if !reflect.DeepEqual(n, 1) {
+        t.Errorf("FoldLeftWhile method result is %v; want %v", n, 1)
+    }
+}
+
+func TestFoldLeftWhileFunctionFoldsFoldableUntilFalse(t *testing.T) {
+    m := NewHashPairMap()
+    m.Set("a", 1)
+    m.Set("b", 2)
+    n := FoldLeftWhile(func(x, y interface{}) (interface{}, bool) {
+            return IntOrElse(x, 0) + 1, false
+    }, 0, m)
+    if !reflect.DeepEqual(n, 1) {
+        t.Errorf("FoldLeftWhile function result is %v; want %v", n, 1)
+    }
+}
+
+func TestAnyFunctionStopsAtFirstTrue(t *testing.T) {
+    n := 0
+    b := Any(func(x interface{}) bool {
+            n++
+            return IntOrElse(x, 0) == 2
+    }, Cons(1, Cons(2, Cons(3, Cons(4, Nil())))))
+    if b != true {
+        t.Errorf("Any function result is %v; want %v", b, true)
+    }
+    if n != 2 {
+        t.Errorf("number of f calls is %v; want %v", n, 2)
+    }
+}
+
+func TestFindFunctionStopsAtFoundElement(t *testing.T) {
+    n := 0
+    o := Find(func(x interface{}) bool {
+            n++
+            return IntOrElse(x, 0) % 2 == 0
+    }, InterfaceSlice([]interface{} { 1, 2, 3, 4 }))
+    if !reflect.DeepEqual(o, Some(2)) {
+        t.Errorf("Find function result is %v; want %v", o, Some(2))
+    }
+    if n != 2 {
+        t.Errorf("number of f calls is %v; want %v", n, 2)
+    }
+}
@@ -70,10 +70,9 @@ func (xs *List) Map(f func(interface{}) interface{}) Functor {
 }
 
 func (xs ST) Map(f func(interface{}) interface{}) Functor {
-    return ST(func(s interface{}) (interface{}, interface{}) {
-            s2, x := xs(s)
-            return s2, f(x)
-    })
+    return ST { m: &xs, f: func(x interface{}) Monad {
+            return STUnit(f(x))
+    } }
 }
 
 func (xs InterfaceSlice) Map(f func(interface{}) interface{}) Functor {
@@ -86,10 +85,11 @@ func (xs InterfaceSlice) Map(f func(interface{}) interface{}) Functor {
 
 func (xs InterfacePairMap) Map(f func(interface{}) interface{}) Functor {
     ys := make(map[interface{}]interface{}, len(xs))
+    ks := newEqualKeys(nil)
     for k, v := range xs {
         p, isOk := f(NewPair(k, v)).(*Pair)
         if isOk {
-            ys[p.First] = p.Second
+            ys[ks.key(p.First)] = p.Second
         }
     }
     return InterfacePairMap(ys)
@@ -100,3 +100,79 @@ func (xs InterfacePairFunction) Map(f func(interface{}) interface{}) Functor {
         return f(xs(x))
     })
 }
+
+func (xs *HashPairMap) Map(f func(interface{}) interface{}) Functor {
+    ys := NewHashPairMap()
+    for _, bucket := range xs.buckets {
+        for _, p := range bucket {
+            p2, isOk := f(NewPair(p.First, p.Second)).(*Pair)
+            if isOk {
+                ys.Set(p2.First, p2.Second)
+            }
+        }
+    }
+    return ys
+}
+
+func (xs *Future) Map(f func(interface{}) interface{}) Functor {
+    return xs.then(func(e *Either) *Either {
+            if e.IsRight() {
+                return Right(f(e.GetRight()))
+            } else {
+                return e
+            }
+    })
+}
+
+func (xs *Lazy) Map(f func(interface{}) interface{}) Functor {
+    return Defer(func() interface{} {
+            return f(xs.Force())
+    })
+}
+
+func (xs *Stream) Map(f func(interface{}) interface{}) Functor {
+    if xs.isCons {
+        return ConsStream(f(xs.head), func() *Stream {
+                return StreamOrElse(xs.Tail().Map(f), EmptyStream())
+        })
+    } else {
+        return EmptyStream()
+    }
+}
+
+func (xs *Vector) Map(f func(interface{}) interface{}) Functor {
+    ys := NewVector()
+    xs.each(func(x interface{}) bool {
+            ys = ys.Append(f(x))
+            return true
+    })
+    return ys
+}
+
+func (xs *HashMap) Map(f func(interface{}) interface{}) Functor {
+    ys := NewHashMap()
+    xs.root.each(func(e *hashMapEntry) bool {
+            p, isOk := f(NewPair(e.key, e.value)).(*Pair)
+            if isOk {
+                ys = ys.Assoc(p.First, p.Second)
+            }
+            return true
+    })
+    return ys
+}
+
+func (xs *FingerTree) Map(f func(interface{}) interface{}) Functor {
+    return xs.MapMeasured(f, xs.m, xs.measure)
+}
+
+func (xs *Queue) Map(f func(interface{}) interface{}) Functor {
+    front := StreamOrElse(xs.front.Map(f), EmptyStream())
+    rear := ListOrElse(xs.rear.Map(f), Nil())
+    return &Queue { front: front, frontLen: xs.frontLen, rear: rear, rearLen: xs.rearLen }
+}
+
+func (xs *Deque) Map(f func(interface{}) interface{}) Functor {
+    front := StreamOrElse(xs.front.Map(f), EmptyStream())
+    rear := StreamOrElse(xs.rear.Map(f), EmptyStream())
+    return &Deque { front: front, frontLen: xs.frontLen, rear: rear, rearLen: xs.rearLen }
+}
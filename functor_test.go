@@ -82,7 +82,7 @@ func TestMapMethodMapsList(t *testing.T) {
 }
 
 func TestMapMethodMapsST(t *testing.T) {
-    xs := ST(func(s interface{}) (interface{}, interface{}) {
+    xs := NewST(func(s interface{}) (interface{}, interface{}) {
             return s, 2
     }).Map(func(x interface{}) interface{} {
             return IntOrElse(x, 0) + 2
@@ -139,6 +139,16 @@ func TestMapMethodMapsInterfacePairMap(t *testing.T) {
     }
 }
 
+func TestMapMethodMergesEqualPairKeysOfInterfacePairMap(t *testing.T) {
+    xs := InterfacePairMapOrElse(InterfacePairMap(map[interface{}]interface{} { "a": 1, "b": 1 }).Map(func(x interface{}) interface{} {
+            p := PairOrElse(x, NewPair("", 0))
+            return NewPair(NewPair(p.Second, 0), p.Second)
+    }), nil)
+    if len(xs) != 1 {
+        t.Errorf("length of Map method result is %v; want %v", len(xs), 1)
+    }
+}
+
 func TestMapMethodMapsInterfacePairFunction(t *testing.T) {
     xs := InterfacePairFunction(func(x interface{}) interface{} {
             return IntOrElse(x, 0) + 1
@@ -0,0 +1,194 @@
+/*
+ * Copyright (c) 2020 Łukasz Szpakowski
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in
+ * all copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+ * THE SOFTWARE.
+ */
+
+package gofun
+import (
+    "context"
+    "fmt"
+    "sync"
+    "time"
+)
+
+// Future represents an asynchronous computation that completes with a value or fails. The
+// failure of Future can be a panic in the computation, a cancellation, a timeout, or the left
+// value of Either. The methods of Foldable wait for the completion of Future. Future is computed
+// only once, so a loop with Future as the condition should use WhileCondM, which creates a new
+// Future in every iteration.
+type Future struct {
+    once sync.Once
+    done chan struct{}
+    result *Either
+}
+
+// PanicError is the error of Future that fails because the computation panics.
+type PanicError struct {
+    // Value is the value that is passed to panic.
+    Value interface{}
+}
+
+func (e *PanicError) Error() string {
+    return fmt.Sprintf("gofun: panic: %v", e.Value)
+}
+
+// FailureError is the error that is returned by Await if the failure of Future isn't an error.
+type FailureError struct {
+    // Value is the failure of Future.
+    Value interface{}
+}
+
+func (e *FailureError) Error() string {
+    return fmt.Sprintf("gofun: failure: %v", e.Value)
+}
+
+// FutureOrElse returns x if x is a pointer to Future, otherwise y.
+func FutureOrElse(x interface{}, y *Future) *Future {
+    return OrElse(x, y)
+}
+
+func newFuture() *Future {
+    return &Future { done: make(chan struct{}) }
+}
+
+// complete completes the future with e if the future isn't completed.
+func (fut *Future) complete(e *Either) {
+    fut.once.Do(func() {
+            fut.result = e
+            close(fut.done)
+    })
+}
+
+// completeWith completes the future with the result of f and turns a panic in f into the failure.
+func (fut *Future) completeWith(f func() *Either) {
+    defer func() {
+        r := recover()
+        if r != nil {
+            fut.complete(Left(&PanicError { Value: r }))
+        }
+    }()
+    fut.complete(f())
+}
+
+// then creates Future that is completed with the result of f for the result of fut. If the new
+// Future is completed earlier, f isn't called.
+func (fut *Future) then(f func(*Either) *Either) *Future {
+    fut2 := newFuture()
+    go func() {
+        select {
+        case <-fut.done:
+            fut2.completeWith(func() *Either {
+                    return f(fut.result)
+            })
+        case <-fut2.done:
+        }
+    }()
+    return fut2
+}
+
+// Async starts f in a new goroutine and returns Future for the result of f. If f panics, Future
+// fails with PanicError.
+func Async(f func() interface{}) *Future {
+    fut := newFuture()
+    go fut.completeWith(func() *Either {
+            return Right(f())
+    })
+    return fut
+}
+
+// FromEither creates the completed Future from e. Future fails with the left value if e is Left.
+func FromEither(e *Either) *Future {
+    fut := newFuture()
+    fut.complete(e)
+    return fut
+}
+
+// Await waits for the completion of Future and returns the value or the failure as an error. If
+// the failure isn't an error, Await returns FailureError. If ctx is done before the completion,
+// Await returns the context error and doesn't cancel Future.
+func (fut *Future) Await(ctx context.Context) (interface{}, error) {
+    select {
+    case <-fut.done:
+    case <-ctx.Done():
+        return nil, ctx.Err()
+    }
+    if fut.result.IsLeft() {
+        err, isOk := fut.result.GetLeft().(error)
+        if !isOk {
+            err = &FailureError { Value: fut.result.GetLeft() }
+        }
+        return nil, err
+    } else {
+        return fut.result.GetRight(), nil
+    }
+}
+
+// ToEither waits for the completion of Future and returns Right with the value or Left with the
+// failure. If ctx is done before the completion, ToEither returns Left with the context error.
+func (fut *Future) ToEither(ctx context.Context) *Either {
+    select {
+    case <-fut.done:
+        return fut.result
+    case <-ctx.Done():
+        return Left(ctx.Err())
+    }
+}
+
+// IsDone returns true if Future is completed, otherwise false.
+func (fut *Future) IsDone() bool {
+    select {
+    case <-fut.done:
+        return true
+    default:
+        return false
+    }
+}
+
+// Cancel fails Future with context.Canceled if Future isn't completed. The computation isn't
+// stopped but its result is ignored.
+func (fut *Future) Cancel() {
+    fut.complete(Left(context.Canceled))
+}
+
+// WithTimeout returns Future that is completed with the result of fut or fails with
+// context.DeadlineExceeded if fut isn't completed before the timeout.
+func (fut *Future) WithTimeout(timeout time.Duration) *Future {
+    fut2 := newFuture()
+    go func() {
+        timer := time.NewTimer(timeout)
+        defer timer.Stop()
+        select {
+        case <-fut.done:
+            fut2.complete(fut.result)
+        case <-timer.C:
+            fut2.complete(Left(context.DeadlineExceeded))
+        case <-fut2.done:
+        }
+    }()
+    return fut2
+}
+
+func (fut *Future) String() string {
+    if fut.IsDone() {
+        return fmt.Sprintf("Future[%v]", fut.result)
+    } else {
+        return "Future[<pending>]"
+    }
+}
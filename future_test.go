@@ -0,0 +1,301 @@
+/*
+ * Copyright (c) 2020 Łukasz Szpakowski
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in
+ * all copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+ * THE SOFTWARE.
+ */
+
+package gofun_test
+import (
+    "context"
+    "reflect"
+    "sync/atomic"
+    "testing"
+    "time"
+    . "gofun"
+)
+
+func TestAsyncFunctionCreatesFuture(t *testing.T) {
+    x, err := Async(func() interface{} {
+            return 2
+    }).Await(context.Background())
+    if err != nil {
+        t.Errorf("Future.Await method second result is %v; want %v", err, nil)
+    }
+    if !reflect.DeepEqual(x, 2) {
+        t.Errorf("Future.Await method first result is %v; want %v", x, 2)
+    }
+}
+
+func TestAsyncFunctionCreatesFutureThatFailsForPanic(t *testing.T) {
+    _, err := Async(func() interface{} {
+            panic("error")
+    }).Await(context.Background())
+    if !reflect.DeepEqual(err, &PanicError { Value: "error" }) {
+        t.Errorf("Future.Await method second result is %v; want %v", err, &PanicError { Value: "error" })
+    }
+}
+
+func TestFromEitherFunctionCreatesFutureForLeft(t *testing.T) {
+    _, err := FromEither(Left("error")).Await(context.Background())
+    if !reflect.DeepEqual(err, &FailureError { Value: "error" }) {
+        t.Errorf("Future.Await method second result is %v; want %v", err, &FailureError { Value: "error" })
+    }
+}
+
+func TestFutureToEitherMethodReturnsEither(t *testing.T) {
+    e := FromEither(Right(1)).ToEither(context.Background())
+    if !reflect.DeepEqual(e, Right(1)) {
+        t.Errorf("Future.ToEither method result is %v; want %v", e, Right(1))
+    }
+    e2 := FromEither(Left("error")).ToEither(context.Background())
+    if !reflect.DeepEqual(e2, Left("error")) {
+        t.Errorf("Future.ToEither method result is %v; want %v", e2, Left("error"))
+    }
+}
+
+func TestFutureAwaitMethodReturnsErrorForDoneContext(t *testing.T) {
+    ch := make(chan struct{})
+    defer close(ch)
+    ctx, cancel := context.WithTimeout(context.Background(), time.Millisecond)
+    defer cancel()
+    _, err := Async(func() interface{} {
+            <-ch
+            return 1
+    }).Await(ctx)
+    if err != context.DeadlineExceeded {
+        t.Errorf("Future.Await method second result is %v; want %v", err, context.DeadlineExceeded)
+    }
+}
+
+func TestFutureCancelMethodCancelsFuture(t *testing.T) {
+    ch := make(chan struct{})
+    defer close(ch)
+    fut := Async(func() interface{} {
+            <-ch
+            return 1
+    })
+    fut.Cancel()
+    _, err := fut.Await(context.Background())
+    if err != context.Canceled {
+        t.Errorf("Future.Await method second result is %v; want %v", err, context.Canceled)
+    }
+}
+
+func TestFutureWithTimeoutMethodFailsAfterTimeout(t *testing.T) {
+    ch := make(chan struct{})
+    defer close(ch)
+    _, err := Async(func() interface{} {
+            <-ch
+            return 1
+    }).WithTimeout(time.Millisecond).Await(context.Background())
+    if err != context.DeadlineExceeded {
+        t.Errorf("Future.Await method second result is %v; want %v", err, context.DeadlineExceeded)
+    }
+}
+
+func TestFutureWithTimeoutMethodReturnsResultBeforeTimeout(t *testing.T) {
+    x, err := FutureUnit(1).(*Future).WithTimeout(time.Minute).Await(context.Background())
+    if err != nil {
+        t.Errorf("Future.Await method second result is %v; want %v", err, nil)
+    }
+    if !reflect.DeepEqual(x, 1) {
+        t.Errorf("Future.Await method first result is %v; want %v", x, 1)
+    }
+}
+
+func TestFutureMapMethodMapsFuture(t *testing.T) {
+    e := Async(func() interface{} {
+            return 2
+    }).Map(func(x interface{}) interface{} {
+            return IntOrElse(x, 0) + 1
+    }).(*Future).ToEither(context.Background())
+    if !reflect.DeepEqual(e, Right(3)) {
+        t.Errorf("Future.Map method result is %v; want %v", e, Right(3))
+    }
+}
+
+func TestFutureMapMethodDoesNotMapFailedFuture(t *testing.T) {
+    e := FromEither(Left("error")).Map(func(x interface{}) interface{} {
+            return IntOrElse(x, 0) + 1
+    }).(*Future).ToEither(context.Background())
+    if !reflect.DeepEqual(e, Left("error")) {
+        t.Errorf("Future.Map method result is %v; want %v", e, Left("error"))
+    }
+}
+
+func TestFutureBindMethodBindsFuture(t *testing.T) {
+    e := Async(func() interface{} {
+            return 2
+    }).Bind(func(x interface{}) Monad {
+            return Async(func() interface{} {
+                    return IntOrElse(x, 0) * 3
+            })
+    }).(*Future).ToEither(context.Background())
+    if !reflect.DeepEqual(e, Right(6)) {
+        t.Errorf("Future.Bind method result is %v; want %v", e, Right(6))
+    }
+}
+
+func TestFutureBindMethodFailsForOtherMonad(t *testing.T) {
+    _, err := FutureUnit(2).Bind(func(x interface{}) Monad {
+            return Some(x)
+    }).(*Future).Await(context.Background())
+    _, isOk := err.(*TypeMismatchError)
+    if isOk != true {
+        t.Errorf("Future.Await method second result is %v; want TypeMismatchError", err)
+    }
+}
+
+func TestFutureZipMethodZipsFutures(t *testing.T) {
+    e := Async(func() interface{} {
+            return 1
+    }).Zip(Async(func() interface{} {
+            return "a"
+    }), nil).(*Future).ToEither(context.Background())
+    if !reflect.DeepEqual(e, Right(NewPair(1, "a"))) {
+        t.Errorf("Future.Zip method result is %v; want %v", e, Right(NewPair(1, "a")))
+    }
+}
+
+func TestFutureZipMethodFailsWithoutWaitingForOtherFuture(t *testing.T) {
+    ch := make(chan struct{})
+    defer close(ch)
+    e := Async(func() interface{} {
+            <-ch
+            return 1
+    }).Zip(FromEither(Left("error")), nil).(*Future).ToEither(context.Background())
+    if !reflect.DeepEqual(e, Left("error")) {
+        t.Errorf("Future.Zip method result is %v; want %v", e, Left("error"))
+    }
+}
+
+func TestFutureUnzipMethodUnzipsFuture(t *testing.T) {
+    xs, ys := FutureUnit(NewPair(1, "a")).(*Future).Unzip(nil)
+    e := xs.(*Future).ToEither(context.Background())
+    if !reflect.DeepEqual(e, Right(1)) {
+        t.Errorf("Future.Unzip method first result is %v; want %v", e, Right(1))
+    }
+    e2 := ys.(*Future).ToEither(context.Background())
+    if !reflect.DeepEqual(e2, Right("a")) {
+        t.Errorf("Future.Unzip method second result is %v; want %v", e2, Right("a"))
+    }
+}
+
+func TestFutureFoldLeftMethodFoldsFuture(t *testing.T) {
+    x := Async(func() interface{} {
+            return 2
+    }).FoldLeft(func(x, y interface{}) interface{} {
+            return IntOrElse(x, 0) + IntOrElse(y, 0)
+    }, 1)
+    if !reflect.DeepEqual(x, 3) {
+        t.Errorf("Future.FoldLeft method result is %v; want %v", x, 3)
+    }
+    x2 := FromEither(Left("error")).FoldLeft(func(x, y interface{}) interface{} {
+            return IntOrElse(x, 0) + IntOrElse(y, 0)
+    }, 1)
+    if !reflect.DeepEqual(x2, 1) {
+        t.Errorf("Future.FoldLeft method result is %v; want %v", x2, 1)
+    }
+}
+
+func TestFoldLeftMFunctionFoldsWithFuture(t *testing.T) {
+    xs := make([]interface{}, 100000)
+    for i := range xs {
+        xs[i] = 1
+    }
+    e := FoldLeftM(func(x, y interface{}) Monad {
+            return Async(func() interface{} {
+                    return IntOrElse(x, 0) + IntOrElse(y, 0)
+            })
+    }, 0, InterfaceSlice(xs), FutureUnit).(*Future).ToEither(context.Background())
+    if !reflect.DeepEqual(e, Right(100000)) {
+        t.Errorf("FoldLeftM function result is %v; want %v", e, Right(100000))
+    }
+}
+
+func TestFilterMFunctionFiltersWithFuture(t *testing.T) {
+    e := FilterM(func(x interface{}) Monad {
+            return Async(func() interface{} {
+                    return IntOrElse(x, 0) % 2 == 0
+            })
+    }, InterfaceSlice([]interface{} { 1, 2, 3, 4 }), FutureUnit).(*Future).ToEither(context.Background())
+    if !reflect.DeepEqual(e, Right(Cons(2, Cons(4, Nil())))) {
+        t.Errorf("FilterM function result is %v; want %v", e, Right(Cons(2, Cons(4, Nil()))))
+    }
+}
+
+func TestWhileMFunctionLoopsWithFuture(t *testing.T) {
+    isCalled := false
+    e := WhileM(Async(func() interface{} {
+            return false
+    }), func() Monad {
+            isCalled = true
+            return FutureUnit(1)
+    }, FutureUnit).(*Future).ToEither(context.Background())
+    if !reflect.DeepEqual(e, Right(struct{} {})) {
+        t.Errorf("WhileM function result is %v; want %v", e, Right(struct{} {}))
+    }
+    if isCalled != false {
+        t.Errorf("body is called")
+    }
+}
+
+func TestWhileCondMFunctionLoopsWithFuture(t *testing.T) {
+    var n int64 = 0
+    e := WhileCondM(func() Monad {
+            return Async(func() interface{} {
+                    return atomic.LoadInt64(&n) < 5
+            })
+    }, func() Monad {
+            return Async(func() interface{} {
+                    return atomic.AddInt64(&n, 1)
+            })
+    }, FutureUnit).(*Future).ToEither(context.Background())
+    if !reflect.DeepEqual(e, Right(struct{} {})) {
+        t.Errorf("WhileCondM function result is %v; want %v", e, Right(struct{} {}))
+    }
+    if atomic.LoadInt64(&n) != 5 {
+        t.Errorf("number of iterations is %v; want %v", atomic.LoadInt64(&n), 5)
+    }
+}
+
+func TestWhileCondMFunctionCallsBodyForTrueFutureCondition(t *testing.T) {
+    conds := []bool { true, false }
+    i := 0
+    isCalled := false
+    e := WhileCondM(func() Monad {
+            b := conds[i]
+            i++
+            return Async(func() interface{} {
+                    return b
+            })
+    }, func() Monad {
+            isCalled = true
+            return FutureUnit(1)
+    }, FutureUnit).(*Future).ToEither(context.Background())
+    if !reflect.DeepEqual(e, Right(struct{} {})) {
+        t.Errorf("WhileCondM function result is %v; want %v", e, Right(struct{} {}))
+    }
+    if isCalled != true {
+        t.Errorf("body isn't called")
+    }
+    if i != 2 {
+        t.Errorf("number of conditions is %v; want %v", i, 2)
+    }
+}
@@ -0,0 +1,187 @@
+/*
+ * Copyright (c) 2020 Łukasz Szpakowski
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in
+ * all copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+ * THE SOFTWARE.
+ */
+
+package generic
+import (
+    "fmt"
+    "gofun"
+)
+
+// Either represents one of two values where the left value has type L and the right value has
+// type R.
+type Either[L, R any] struct {
+    isRight bool
+    left L
+    right R
+}
+
+// Left creates an Either with a left value.
+func Left[L, R any](x L) *Either[L, R] {
+    return &Either[L, R] { isRight: false, left: x }
+}
+
+// Right creates an Either with a right value.
+func Right[L, R any](x R) *Either[L, R] {
+    return &Either[L, R] { isRight: true, right: x }
+}
+
+// IsLeft returns true if e contains the left value, otherwise false.
+func (e *Either[L, R]) IsLeft() bool {
+    return !e.isRight
+}
+
+// IsRight returns true if e contains the right value, otherwise false.
+func (e *Either[L, R]) IsRight() bool {
+    return e.isRight
+}
+
+// GetLeft returns the left value. If e contains the right value, GetLeft returns the zero value
+// of L.
+func (e *Either[L, R]) GetLeft() L {
+    return e.left
+}
+
+// GetRight returns the right value. If e contains the left value, GetRight returns the zero value
+// of R.
+func (e *Either[L, R]) GetRight() R {
+    return e.right
+}
+
+// GetLeftOrElse returns the left value if e contains the left value, otherwise x().
+func (e *Either[L, R]) GetLeftOrElse(x func() L) L {
+    if e.isRight {
+        return x()
+    } else {
+        return e.left
+    }
+}
+
+// LeftOrElse returns e if e contains the left value, otherwise e2().
+func (e *Either[L, R]) LeftOrElse(e2 func() *Either[L, R]) *Either[L, R] {
+    if e.isRight {
+        return e2()
+    } else {
+        return e
+    }
+}
+
+// GetRightOrElse returns the right value if e contains the right value, otherwise x().
+func (e *Either[L, R]) GetRightOrElse(x func() R) R {
+    if e.isRight {
+        return e.right
+    } else {
+        return x()
+    }
+}
+
+// RightOrElse returns e if e contains the right value, otherwise e2().
+func (e *Either[L, R]) RightOrElse(e2 func() *Either[L, R]) *Either[L, R] {
+    if e.isRight {
+        return e
+    } else {
+        return e2()
+    }
+}
+
+// Swap swaps the left value and the right value.
+func (e *Either[L, R]) Swap() *Either[R, L] {
+    if e.isRight {
+        return Left[R, L](e.right)
+    } else {
+        return Right[R, L](e.left)
+    }
+}
+
+func (e *Either[L, R]) String() string {
+    if e.isRight {
+        return fmt.Sprintf("Right[%v]", e.right)
+    } else {
+        return fmt.Sprintf("Left[%v]", e.left)
+    }
+}
+
+// EitherFold returns onLeft(x) if e contains the left value x, otherwise onRight(y) for the right
+// value y.
+func EitherFold[L, R, T any](e *Either[L, R], onLeft func(L) T, onRight func(R) T) T {
+    if e.isRight {
+        return onRight(e.right)
+    } else {
+        return onLeft(e.left)
+    }
+}
+
+// EitherMap maps the right value of e by f.
+func EitherMap[L, R, U any](e *Either[L, R], f func(R) U) *Either[L, U] {
+    if e.isRight {
+        return Right[L](f(e.right))
+    } else {
+        return Left[L, U](e.left)
+    }
+}
+
+// EitherMapLeft maps the left value of e by f.
+func EitherMapLeft[L, R, U any](e *Either[L, R], f func(L) U) *Either[U, R] {
+    if e.isRight {
+        return Right[U](e.right)
+    } else {
+        return Left[U, R](f(e.left))
+    }
+}
+
+// EitherFlatMap maps the right value of e by f and flattens the result.
+func EitherFlatMap[L, R, U any](e *Either[L, R], f func(R) *Either[L, U]) *Either[L, U] {
+    if e.isRight {
+        return f(e.right)
+    } else {
+        return Left[L, U](e.left)
+    }
+}
+
+// FromEither converts gofun.Either to Either. The second result is false if the value of e
+// doesn't have the type of its side; in that case the first result is nil. Nil is converted to the
+// zero value of the side type if that type can be nil.
+func FromEither[L, R any](e *gofun.Either) (*Either[L, R], bool) {
+    if e.IsRight() {
+        x, isOk := cast[R](e.GetRight())
+        if isOk {
+            return Right[L](x), true
+        } else {
+            return nil, false
+        }
+    } else {
+        x, isOk := cast[L](e.GetLeft())
+        if isOk {
+            return Left[L, R](x), true
+        } else {
+            return nil, false
+        }
+    }
+}
+
+// ToEither converts Either to gofun.Either.
+func ToEither[L, R any](e *Either[L, R]) *gofun.Either {
+    if e.isRight {
+        return gofun.Right(e.right)
+    } else {
+        return gofun.Left(e.left)
+    }
+}
@@ -0,0 +1,149 @@
+/*
+ * Copyright (c) 2020 Łukasz Szpakowski
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in
+ * all copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+ * THE SOFTWARE.
+ */
+
+package generic_test
+import (
+    "errors"
+    "reflect"
+    "testing"
+    "gofun"
+    . "gofun/generic"
+)
+
+func TestEitherGetRightMethodReturnsNilRightValue(t *testing.T) {
+    e := Right[string, error](nil)
+    if !e.IsRight() {
+        t.Errorf("Either.IsRight method result is %v; want %v", e.IsRight(), true)
+    }
+    if e.GetRight() != nil {
+        t.Errorf("Either.GetRight method result is %v; want %v", e.GetRight(), nil)
+    }
+}
+
+func TestEitherSwapMethodSwapsLeft(t *testing.T) {
+    e := Left[string, int]("error").Swap()
+    if !reflect.DeepEqual(e, Right[int]("error")) {
+        t.Errorf("Either.Swap method result is %v; want %v", e, Right[int]("error"))
+    }
+}
+
+func TestEitherSwapMethodSwapsRight(t *testing.T) {
+    e := Right[string](2).Swap()
+    if !reflect.DeepEqual(e, Left[int, string](2)) {
+        t.Errorf("Either.Swap method result is %v; want %v", e, Left[int, string](2))
+    }
+}
+
+func TestEitherFoldFunctionFoldsLeft(t *testing.T) {
+    x := EitherFold(Left[string, int]("error"), func(x string) int { return len(x) }, func(y int) int { return y + 1 })
+    if x != 5 {
+        t.Errorf("EitherFold function result is %v; want %v", x, 5)
+    }
+}
+
+func TestEitherFoldFunctionFoldsRight(t *testing.T) {
+    x := EitherFold(Right[string](2), func(x string) int { return len(x) }, func(y int) int { return y + 1 })
+    if x != 3 {
+        t.Errorf("EitherFold function result is %v; want %v", x, 3)
+    }
+}
+
+func TestEitherMapFunctionMapsLeft(t *testing.T) {
+    e := EitherMap(Left[string, int]("error"), func(x int) bool { return x > 1 })
+    if !reflect.DeepEqual(e, Left[string, bool]("error")) {
+        t.Errorf("EitherMap function result is %v; want %v", e, Left[string, bool]("error"))
+    }
+}
+
+func TestEitherMapFunctionMapsRight(t *testing.T) {
+    e := EitherMap(Right[string](2), func(x int) bool { return x > 1 })
+    if !reflect.DeepEqual(e, Right[string](true)) {
+        t.Errorf("EitherMap function result is %v; want %v", e, Right[string](true))
+    }
+}
+
+func TestEitherMapLeftFunctionMapsLeft(t *testing.T) {
+    e := EitherMapLeft(Left[string, int]("error"), func(x string) error { return errors.New(x) })
+    if !reflect.DeepEqual(e, Left[error, int](errors.New("error"))) {
+        t.Errorf("EitherMapLeft function result is %v; want %v", e, Left[error, int](errors.New("error")))
+    }
+}
+
+func TestEitherFlatMapFunctionFlatMapsRight(t *testing.T) {
+    e := EitherFlatMap(Right[string](2), func(x int) *Either[string, int] {
+            if x > 2 {
+                return Right[string](x + 1)
+            } else {
+                return Left[string, int]("too small")
+            }
+    })
+    if !reflect.DeepEqual(e, Left[string, int]("too small")) {
+        t.Errorf("EitherFlatMap function result is %v; want %v", e, Left[string, int]("too small"))
+    }
+}
+
+func TestFromEitherFunctionConvertsLeft(t *testing.T) {
+    e, isOk := FromEither[string, int](gofun.Left("error"))
+    if !isOk {
+        t.Errorf("FromEither function second result is %v; want %v", isOk, true)
+    }
+    if !reflect.DeepEqual(e, Left[string, int]("error")) {
+        t.Errorf("FromEither function first result is %v; want %v", e, Left[string, int]("error"))
+    }
+}
+
+func TestFromEitherFunctionDoesNotConvertRightWithOtherType(t *testing.T) {
+    e, isOk := FromEither[string, int](gofun.Right("a"))
+    if isOk {
+        t.Errorf("FromEither function second result is %v; want %v", isOk, false)
+    }
+    if e != nil {
+        t.Errorf("FromEither function first result is %v; want %v", e, nil)
+    }
+}
+
+func TestFromEitherFunctionConvertsRightWithNilPointer(t *testing.T) {
+    e, isOk := FromEither[string, *int](gofun.Right(nil))
+    if !isOk {
+        t.Errorf("FromEither function second result is %v; want %v", isOk, true)
+    }
+    if !reflect.DeepEqual(e, Right[string, *int](nil)) {
+        t.Errorf("FromEither function first result is %v; want %v", e, Right[string, *int](nil))
+    }
+}
+
+func TestFromEitherFunctionConvertsLeftWithNilInterface(t *testing.T) {
+    e, isOk := FromEither[error, int](gofun.Left(nil))
+    if !isOk {
+        t.Errorf("FromEither function second result is %v; want %v", isOk, true)
+    }
+    if !reflect.DeepEqual(e, Left[error, int](nil)) {
+        t.Errorf("FromEither function first result is %v; want %v", e, Left[error, int](nil))
+    }
+}
+
+func TestToEitherFunctionConvertsRight(t *testing.T) {
+    e := ToEither(Right[string](2))
+    if !reflect.DeepEqual(e, gofun.Right(2)) {
+        t.Errorf("ToEither function result is %v; want %v", e, gofun.Right(2))
+    }
+}
@@ -0,0 +1,305 @@
+/*
+ * Copyright (c) 2020 Łukasz Szpakowski
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in
+ * all copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+ * THE SOFTWARE.
+ */
+
+package generic
+import "gofun"
+
+// Foldable is the interface for folding of elements of type T.
+type Foldable[T any] interface {
+    // Each calls f for the elements from left side until f returns false.
+    Each(f func(T) bool)
+}
+
+type (
+    // Slice encapsulates []T.
+    Slice[T any] []T
+    // Map encapsulates map[K]V. Map is Foldable of pairs of keys and values.
+    Map[K comparable, V any] map[K]V
+)
+
+// All returns true if f returns true for all elements, otherwise false.
+func All[T any](f func(T) bool, xs Foldable[T]) bool {
+    y := true
+    xs.Each(func(x T) bool {
+            y = f(x)
+            return y
+    })
+    return y
+}
+
+// Any returns true if f returns true for any element, otherwise false.
+func Any[T any](f func(T) bool, xs Foldable[T]) bool {
+    y := false
+    xs.Each(func(x T) bool {
+            y = f(x)
+            return !y
+    })
+    return y
+}
+
+// Element returns true if Foldable contains the element, otherwise false.
+func Element[T comparable](x T, xs Foldable[T]) bool {
+    return Any(func(y T) bool { return y == x }, xs)
+}
+
+// NotElement is the Element negation.
+func NotElement[T comparable](x T, xs Foldable[T]) bool {
+    return !Element(x, xs)
+}
+
+// Filter filters the elements and returns a list of the filtered elements.
+func Filter[T any](f func(T) bool, xs Foldable[T]) *List[T] {
+    ys := Nil[T]()
+    var prev *List[T] = nil
+    xs.Each(func(x T) bool {
+            if f(x) {
+                l := Cons(x, Nil[T]())
+                if prev != nil {
+                    prev.tail = l
+                } else {
+                    ys = l
+                }
+                prev = l
+            }
+            return true
+    })
+    return ys
+}
+
+// FilterSlice filters the elements and returns a slice of the filtered elements.
+func FilterSlice[T any](f func(T) bool, xs Foldable[T]) []T {
+    ys := make([]T, 0)
+    xs.Each(func(x T) bool {
+            if f(x) {
+                ys = append(ys, x)
+            }
+            return true
+    })
+    return ys
+}
+
+// Find finds the element and returns the optional found element.
+func Find[T any](f func(T) bool, xs Foldable[T]) *Option[T] {
+    y := None[T]()
+    xs.Each(func(x T) bool {
+            if f(x) {
+                y = Some(x)
+                return false
+            } else {
+                return true
+            }
+    })
+    return y
+}
+
+// FoldLeft folds Foldable from left side. Left folding is calculated
+// f(...f(f(z, xs[0]), xs[1])..., xs[n-1]).
+func FoldLeft[T, U any](f func(U, T) U, z U, xs Foldable[T]) U {
+    y := z
+    xs.Each(func(x T) bool {
+            y = f(y, x)
+            return true
+    })
+    return y
+}
+
+// FoldRight folds Foldable from right side. Right folding is calculated
+// f(xs[0], f(xs[1], ...f(xs[n-1], z)...)).
+func FoldRight[T, U any](f func(T, U) U, z U, xs Foldable[T]) U {
+    ys := ToSlice(xs)
+    y := z
+    for i := len(ys) - 1; i >= 0; i-- {
+        y = f(ys[i], y)
+    }
+    return y
+}
+
+// FoldLeftM is similar to FoldLeft but returns gofun.Monad and f returns gofun.Monad instead of a
+// value. F must return Monad that contains U. Unit must be the unit function for specified monad.
+func FoldLeftM[T, U any](f func(U, T) gofun.Monad, z U, xs Foldable[T], unit func(interface{}) gofun.Monad) gofun.Monad {
+    return gofun.FoldLeftM(func(x, y interface{}) gofun.Monad {
+            return f(mustCast[U](x, "FoldLeftM accumulator"), mustCast[T](y, "FoldLeftM element"))
+    }, z, toInterfaceSlice(xs), unit)
+}
+
+// FoldRightM is similar to FoldRight but returns gofun.Monad and f returns gofun.Monad instead of a
+// value. F must return Monad that contains U. Unit must be the unit function for specified monad.
+func FoldRightM[T, U any](f func(T, U) gofun.Monad, z U, xs Foldable[T], unit func(interface{}) gofun.Monad) gofun.Monad {
+    return gofun.FoldRightM(func(y, x interface{}) gofun.Monad {
+            return f(mustCast[T](y, "FoldRightM element"), mustCast[U](x, "FoldRightM accumulator"))
+    }, z, toInterfaceSlice(xs), unit)
+}
+
+// Length returns the length of Foldable.
+func Length[T any](xs Foldable[T]) int {
+    n := 0
+    xs.Each(func(x T) bool {
+            n++
+            return true
+    })
+    return n
+}
+
+// Null returns true if Foldable is empty, otherwise false.
+func Null[T any](xs Foldable[T]) bool {
+    y := true
+    xs.Each(func(x T) bool {
+            y = false
+            return false
+    })
+    return y
+}
+
+// ListFrom converts Foldable to a list. Use ToList to convert List to gofun.List.
+func ListFrom[T any](xs Foldable[T]) *List[T] {
+    return Filter(func(x T) bool { return true }, xs)
+}
+
+// ToSlice converts Foldable to a slice.
+func ToSlice[T any](xs Foldable[T]) []T {
+    return FilterSlice(func(x T) bool { return true }, xs)
+}
+
+// SliceAll is All for a plain slice.
+func SliceAll[T any](f func(T) bool, xs []T) bool {
+    return All[T](f, Slice[T](xs))
+}
+
+// SliceAny is Any for a plain slice.
+func SliceAny[T any](f func(T) bool, xs []T) bool {
+    return Any[T](f, Slice[T](xs))
+}
+
+// SliceElement is Element for a plain slice.
+func SliceElement[T comparable](x T, xs []T) bool {
+    return Element[T](x, Slice[T](xs))
+}
+
+// SliceFilter is FilterSlice for a plain slice.
+func SliceFilter[T any](f func(T) bool, xs []T) []T {
+    return FilterSlice[T](f, Slice[T](xs))
+}
+
+// SliceFind is Find for a plain slice.
+func SliceFind[T any](f func(T) bool, xs []T) *Option[T] {
+    return Find[T](f, Slice[T](xs))
+}
+
+// SliceFoldLeft is FoldLeft for a plain slice.
+func SliceFoldLeft[T, U any](f func(U, T) U, z U, xs []T) U {
+    return FoldLeft[T](f, z, Slice[T](xs))
+}
+
+// SliceFoldRight is FoldRight for a plain slice.
+func SliceFoldRight[T, U any](f func(T, U) U, z U, xs []T) U {
+    return FoldRight[T](f, z, Slice[T](xs))
+}
+
+// SliceFoldLeftM is FoldLeftM for a plain slice.
+func SliceFoldLeftM[T, U any](f func(U, T) gofun.Monad, z U, xs []T, unit func(interface{}) gofun.Monad) gofun.Monad {
+    return FoldLeftM[T](f, z, Slice[T](xs), unit)
+}
+
+// SliceFoldRightM is FoldRightM for a plain slice.
+func SliceFoldRightM[T, U any](f func(T, U) gofun.Monad, z U, xs []T, unit func(interface{}) gofun.Monad) gofun.Monad {
+    return FoldRightM[T](f, z, Slice[T](xs), unit)
+}
+
+// MapAll is All for a plain map.
+func MapAll[K comparable, V any](f func(*Pair[K, V]) bool, xs map[K]V) bool {
+    return All[*Pair[K, V]](f, Map[K, V](xs))
+}
+
+// MapAny is Any for a plain map.
+func MapAny[K comparable, V any](f func(*Pair[K, V]) bool, xs map[K]V) bool {
+    return Any[*Pair[K, V]](f, Map[K, V](xs))
+}
+
+// MapFilter is FilterSlice for a plain map.
+func MapFilter[K comparable, V any](f func(*Pair[K, V]) bool, xs map[K]V) []*Pair[K, V] {
+    return FilterSlice[*Pair[K, V]](f, Map[K, V](xs))
+}
+
+// MapFind is Find for a plain map.
+func MapFind[K comparable, V any](f func(*Pair[K, V]) bool, xs map[K]V) *Option[*Pair[K, V]] {
+    return Find[*Pair[K, V]](f, Map[K, V](xs))
+}
+
+// MapFoldLeft is FoldLeft for a plain map.
+func MapFoldLeft[K comparable, V, U any](f func(U, *Pair[K, V]) U, z U, xs map[K]V) U {
+    return FoldLeft[*Pair[K, V]](f, z, Map[K, V](xs))
+}
+
+// MapFoldLeftM is FoldLeftM for a plain map.
+func MapFoldLeftM[K comparable, V, U any](f func(U, *Pair[K, V]) gofun.Monad, z U, xs map[K]V, unit func(interface{}) gofun.Monad) gofun.Monad {
+    return FoldLeftM[*Pair[K, V]](f, z, Map[K, V](xs), unit)
+}
+
+// MapToSlice is ToSlice for a plain map.
+func MapToSlice[K comparable, V any](xs map[K]V) []*Pair[K, V] {
+    return ToSlice[*Pair[K, V]](Map[K, V](xs))
+}
+
+func toInterfaceSlice[T any](xs Foldable[T]) gofun.InterfaceSlice {
+    ys := make([]interface{}, 0)
+    xs.Each(func(x T) bool {
+            ys = append(ys, x)
+            return true
+    })
+    return gofun.InterfaceSlice(ys)
+}
+
+func (xs *Option[T]) Each(f func(T) bool) {
+    if xs.isSome {
+        f(xs.x)
+    }
+}
+
+func (xs *Either[L, R]) Each(f func(R) bool) {
+    if xs.isRight {
+        f(xs.right)
+    }
+}
+
+func (xs *List[T]) Each(f func(T) bool) {
+    for l := xs; l.isCons; l = l.tail {
+        if !f(l.head) {
+            break
+        }
+    }
+}
+
+func (xs Slice[T]) Each(f func(T) bool) {
+    for _, x := range xs {
+        if !f(x) {
+            break
+        }
+    }
+}
+
+func (xs Map[K, V]) Each(f func(*Pair[K, V]) bool) {
+    for k, v := range xs {
+        if !f(NewPair(k, v)) {
+            break
+        }
+    }
+}
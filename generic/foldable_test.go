@@ -0,0 +1,202 @@
+/*
+ * Copyright (c) 2020 Łukasz Szpakowski
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in
+ * all copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+ * THE SOFTWARE.
+ */
+
+package generic_test
+import (
+    "reflect"
+    "testing"
+    "gofun"
+    . "gofun/generic"
+)
+
+type user struct {
+    name string
+    age int
+}
+
+func TestAllFunctionReturnsTrueForSlice(t *testing.T) {
+    b := All(func(x int) bool { return x > 0 }, Slice[int]([]int { 1, 2, 3 }))
+    if !b {
+        t.Errorf("All function result is %v; want %v", b, true)
+    }
+}
+
+func TestAllFunctionStopsAtFirstFalse(t *testing.T) {
+    n := 0
+    b := All(func(x int) bool {
+            n++
+            return x < 2
+    }, ListOf(1, 2, 3, 4))
+    if b {
+        t.Errorf("All function result is %v; want %v", b, false)
+    }
+    if n != 2 {
+        t.Errorf("number of f calls is %v; want %v", n, 2)
+    }
+}
+
+func TestAnyFunctionReturnsTrueForRight(t *testing.T) {
+    b := Any(func(x int) bool { return x > 1 }, Right[string](2))
+    if !b {
+        t.Errorf("Any function result is %v; want %v", b, true)
+    }
+}
+
+func TestAnyFunctionReturnsFalseForNone(t *testing.T) {
+    b := Any(func(x int) bool { return x > 1 }, None[int]())
+    if b {
+        t.Errorf("Any function result is %v; want %v", b, false)
+    }
+}
+
+func TestElementFunctionReturnsTrueForList(t *testing.T) {
+    b := Element(2, ListOf(1, 2, 3))
+    if !b {
+        t.Errorf("Element function result is %v; want %v", b, true)
+    }
+}
+
+func TestFilterFunctionFiltersSliceOfStructs(t *testing.T) {
+    users := []user { { "a", 10 }, { "b", 20 }, { "c", 30 } }
+    xs := Filter(func(u user) bool { return u.age > 15 }, Slice[user](users))
+    if !reflect.DeepEqual(xs, ListOf(users[1], users[2])) {
+        t.Errorf("Filter function result is %v; want %v", xs, ListOf(users[1], users[2]))
+    }
+}
+
+func TestFilterSliceFunctionFiltersMap(t *testing.T) {
+    xs := FilterSlice(func(p *Pair[string, int]) bool { return p.Second > 1 }, Map[string, int](map[string]int { "a": 1, "b": 2 }))
+    if !reflect.DeepEqual(xs, []*Pair[string, int] { NewPair("b", 2) }) {
+        t.Errorf("FilterSlice function result is %v; want %v", xs, []*Pair[string, int] { NewPair("b", 2) })
+    }
+}
+
+func TestFindFunctionFindsElement(t *testing.T) {
+    o := Find(func(x int) bool { return x % 2 == 0 }, ListOf(1, 3, 4, 6))
+    if !reflect.DeepEqual(o, Some(4)) {
+        t.Errorf("Find function result is %v; want %v", o, Some(4))
+    }
+}
+
+func TestFindFunctionDoesNotFindElement(t *testing.T) {
+    o := Find(func(x int) bool { return x % 2 == 0 }, Slice[int]([]int { 1, 3 }))
+    if !reflect.DeepEqual(o, None[int]()) {
+        t.Errorf("Find function result is %v; want %v", o, None[int]())
+    }
+}
+
+func TestFoldLeftFunctionFoldsSlice(t *testing.T) {
+    x := FoldLeft(func(x string, y int) string { return x + string(rune('a' + y)) }, "z", Slice[int]([]int { 0, 1, 2 }))
+    if x != "zabc" {
+        t.Errorf("FoldLeft function result is %v; want %v", x, "zabc")
+    }
+}
+
+func TestFoldRightFunctionFoldsList(t *testing.T) {
+    x := FoldRight(func(y int, x string) string { return x + string(rune('a' + y)) }, "z", ListOf(0, 1, 2))
+    if x != "zcba" {
+        t.Errorf("FoldRight function result is %v; want %v", x, "zcba")
+    }
+}
+
+func TestFoldLeftMFunctionFoldsSliceForOption(t *testing.T) {
+    m := FoldLeftM(func(x int, y int) gofun.Monad {
+            return gofun.OptionUnit(x + y)
+    }, 1, Slice[int]([]int { 2, 3 }), gofun.OptionUnit)
+    if !reflect.DeepEqual(m, gofun.Some(6)) {
+        t.Errorf("FoldLeftM function result is %v; want %v", m, gofun.Some(6))
+    }
+}
+
+func TestFoldLeftMFunctionFoldsWithNilInterfaceAccumulator(t *testing.T) {
+    m := FoldLeftM(func(x error, y int) gofun.Monad {
+            return gofun.OptionUnit(x)
+    }, nil, Slice[int]([]int { 2, 3 }), gofun.OptionUnit)
+    if !reflect.DeepEqual(m, gofun.Some(nil)) {
+        t.Errorf("FoldLeftM function result is %v; want %v", m, gofun.Some(nil))
+    }
+}
+
+func TestFoldRightMFunctionFoldsNilPointerElements(t *testing.T) {
+    m := FoldRightM(func(y *int, x int) gofun.Monad {
+            if y == nil {
+                return gofun.OptionUnit(x + 1)
+            } else {
+                return gofun.OptionUnit(x + *y)
+            }
+    }, 0, Slice[*int]([]*int { nil, nil }), gofun.OptionUnit)
+    if !reflect.DeepEqual(m, gofun.Some(2)) {
+        t.Errorf("FoldRightM function result is %v; want %v", m, gofun.Some(2))
+    }
+}
+
+func TestSliceFilterFunctionFiltersPlainSlice(t *testing.T) {
+    users := []user { { "a", 10 }, { "b", 20 }, { "c", 30 } }
+    xs := SliceFilter(func(u user) bool { return u.age > 15 }, users)
+    if !reflect.DeepEqual(xs, []user { users[1], users[2] }) {
+        t.Errorf("SliceFilter function result is %v; want %v", xs, []user { users[1], users[2] })
+    }
+}
+
+func TestSliceFoldLeftMFunctionFoldsPlainSlice(t *testing.T) {
+    m := SliceFoldLeftM(func(x int, y int) gofun.Monad {
+            return gofun.OptionUnit(x + y)
+    }, 1, []int { 2, 3 }, gofun.OptionUnit)
+    if !reflect.DeepEqual(m, gofun.Some(6)) {
+        t.Errorf("SliceFoldLeftM function result is %v; want %v", m, gofun.Some(6))
+    }
+}
+
+func TestMapFoldLeftFunctionFoldsPlainMap(t *testing.T) {
+    x := MapFoldLeft(func(x int, p *Pair[string, int]) int { return x + p.Second }, 1, map[string]int { "a": 2, "b": 3 })
+    if x != 6 {
+        t.Errorf("MapFoldLeft function result is %v; want %v", x, 6)
+    }
+}
+
+func TestLengthFunctionReturnsLengthOfList(t *testing.T) {
+    n := Length(ListOf(1, 2, 3))
+    if n != 3 {
+        t.Errorf("Length function result is %v; want %v", n, 3)
+    }
+}
+
+func TestNullFunctionReturnsTrueForEmptySlice(t *testing.T) {
+    b := Null(Slice[int]([]int {}))
+    if !b {
+        t.Errorf("Null function result is %v; want %v", b, true)
+    }
+}
+
+func TestListFromFunctionConvertsSlice(t *testing.T) {
+    xs := ListFrom(Slice[int]([]int { 1, 2 }))
+    if !reflect.DeepEqual(xs, ListOf(1, 2)) {
+        t.Errorf("ListFrom function result is %v; want %v", xs, ListOf(1, 2))
+    }
+}
+
+func TestToSliceFunctionConvertsList(t *testing.T) {
+    xs := ToSlice(ListOf(1, 2))
+    if !reflect.DeepEqual(xs, []int { 1, 2 }) {
+        t.Errorf("ToSlice function result is %v; want %v", xs, []int { 1, 2 })
+    }
+}
@@ -0,0 +1,290 @@
+/*
+ * Copyright (c) 2020 Łukasz Szpakowski
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in
+ * all copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+ * THE SOFTWARE.
+ */
+
+package generic
+import (
+    "fmt"
+    "gofun"
+)
+
+// List represents immutable value lists of type T. Lists returned by the List functions and
+// methods share their tails with the source lists where it is possible.
+type List[T any] struct {
+    isCons bool
+    head T
+    tail *List[T]
+}
+
+// Nil creates an empty list.
+func Nil[T any]() *List[T] {
+    return &List[T] { isCons: false }
+}
+
+// Cons creates a list with a first element and a tail that is other list.
+func Cons[T any](head T, tail *List[T]) *List[T] {
+    return &List[T] { isCons: true, head: head, tail: tail }
+}
+
+// ListOf creates a list of the elements.
+func ListOf[T any](xs ...T) *List[T] {
+    ys := Nil[T]()
+    for i := len(xs) - 1; i >= 0; i-- {
+        ys = Cons(xs[i], ys)
+    }
+    return ys
+}
+
+// IsNil returns true if list is empty, otherwise false.
+func (l *List[T]) IsNil() bool {
+    return !l.isCons
+}
+
+// IsCons returns true if list isn't empty, otherwise false.
+func (l *List[T]) IsCons() bool {
+    return l.isCons
+}
+
+// Head returns the first element.
+func (l *List[T]) Head() T {
+    return l.head
+}
+
+// HeadOption returns the optional first element.
+func (l *List[T]) HeadOption() *Option[T] {
+    if l.isCons {
+        return Some(l.head)
+    } else {
+        return None[T]()
+    }
+}
+
+// Tail returns the list of elements except the first element.
+func (l *List[T]) Tail() *List[T] {
+    return l.tail
+}
+
+// TailOption returns the optional list of elements except the first element.
+func (l *List[T]) TailOption() *Option[*List[T]] {
+    if l.isCons {
+        return Some(l.tail)
+    } else {
+        return None[*List[T]]()
+    }
+}
+
+func (l *List[T]) String() string {
+    s := "List["
+    isFirst := true
+    for l2 := l; l2.isCons; l2 = l2.tail {
+        if !isFirst {
+            s += " "
+        }
+        s += fmt.Sprintf("%v", l2.head)
+        isFirst = false
+    }
+    s += "]"
+    return s
+}
+
+// copyPrefix copies the elements of xs before end and sets the tail of the last copied element to
+// ys.
+func copyPrefix[T any](xs, end, ys *List[T]) *List[T] {
+    zs := ys
+    var prev *List[T] = nil
+    for l := xs; l != end && l.isCons; l = l.tail {
+        l2 := Cons(l.head, ys)
+        if prev != nil {
+            prev.tail = l2
+        } else {
+            zs = l2
+        }
+        prev = l2
+    }
+    return zs
+}
+
+// Concat concatenates two lists. The result shares ys.
+func (xs *List[T]) Concat(ys *List[T]) *List[T] {
+    if ys.isCons {
+        return copyPrefix(xs, nil, ys)
+    } else {
+        return xs
+    }
+}
+
+// Filter filters the elements. The result shares the longest tail of xs that only contains the
+// elements for which f returns true.
+func (xs *List[T]) Filter(f func(T) bool) *List[T] {
+    var ys *List[T] = nil
+    var prev *List[T] = nil
+    kept := xs
+    for l := xs; l.isCons; l = l.tail {
+        if !f(l.head) {
+            for l2 := kept; l2 != l; l2 = l2.tail {
+                l3 := Cons(l2.head, Nil[T]())
+                if prev != nil {
+                    prev.tail = l3
+                } else {
+                    ys = l3
+                }
+                prev = l3
+            }
+            kept = l.tail
+        }
+    }
+    if prev != nil {
+        prev.tail = kept
+        return ys
+    } else {
+        return kept
+    }
+}
+
+// Reverse reverses the list.
+func (xs *List[T]) Reverse() *List[T] {
+    ys := Nil[T]()
+    for l := xs; l.isCons; l = l.tail {
+        ys = Cons(l.head, ys)
+    }
+    return ys
+}
+
+// Take returns the first n elements. If xs has at most n elements, Take returns xs.
+func (xs *List[T]) Take(n int) *List[T] {
+    l := xs
+    for i := 0; i < n && l.isCons; i++ {
+        l = l.tail
+    }
+    if l.isCons {
+        return copyPrefix(xs, l, Nil[T]())
+    } else {
+        return xs
+    }
+}
+
+// Drop returns the list of elements except the first n elements. The result is the tail of xs.
+func (xs *List[T]) Drop(n int) *List[T] {
+    l := xs
+    for i := 0; i < n && l.isCons; i++ {
+        l = l.tail
+    }
+    return l
+}
+
+// ListMap maps the elements of xs by f.
+func ListMap[T, U any](xs *List[T], f func(T) U) *List[U] {
+    ys := Nil[U]()
+    var prev *List[U] = nil
+    for l := xs; l.isCons; l = l.tail {
+        l2 := Cons(f(l.head), Nil[U]())
+        if prev != nil {
+            prev.tail = l2
+        } else {
+            ys = l2
+        }
+        prev = l2
+    }
+    return ys
+}
+
+// ListFlatMap maps the elements of xs by f and concatenates the results. The result shares the
+// last list returned by f.
+func ListFlatMap[T, U any](xs *List[T], f func(T) *List[U]) *List[U] {
+    yss := make([]*List[U], 0)
+    for l := xs; l.isCons; l = l.tail {
+        yss = append(yss, f(l.head))
+    }
+    ys := Nil[U]()
+    for i := len(yss) - 1; i >= 0; i-- {
+        ys = yss[i].Concat(ys)
+    }
+    return ys
+}
+
+// ListFoldLeft folds the list from left side. Left folding is calculated
+// f(...f(f(z, xs[0]), xs[1])..., xs[n-1]).
+func ListFoldLeft[T, U any](xs *List[T], f func(U, T) U, z U) U {
+    y := z
+    for l := xs; l.isCons; l = l.tail {
+        y = f(y, l.head)
+    }
+    return y
+}
+
+// ListFoldRight folds the list from right side. Right folding is calculated
+// f(xs[0], f(xs[1], ...f(xs[n-1], z)...)).
+func ListFoldRight[T, U any](xs *List[T], f func(T, U) U, z U) U {
+    ys := make([]T, 0)
+    for l := xs; l.isCons; l = l.tail {
+        ys = append(ys, l.head)
+    }
+    y := z
+    for i := len(ys) - 1; i >= 0; i-- {
+        y = f(ys[i], y)
+    }
+    return y
+}
+
+// FromList converts gofun.Foldable, for example gofun.List, to List. The second result is false if
+// any element of xs isn't T; in that case the first result is nil. Nil is converted to the zero
+// value of T if T can be nil.
+func FromList[T any](xs gofun.Foldable) (*List[T], bool) {
+    ys := Nil[T]()
+    var prev *List[T] = nil
+    isOk := gofun.BoolOrElse(xs.FoldLeft(func(x, y interface{}) interface{} {
+            if !gofun.BoolOrElse(x, false) {
+                return false
+            }
+            y2, isOk2 := cast[T](y)
+            if isOk2 {
+                l := Cons(y2, Nil[T]())
+                if prev != nil {
+                    prev.tail = l
+                } else {
+                    ys = l
+                }
+                prev = l
+            }
+            return isOk2
+    }, true), false)
+    if isOk {
+        return ys, true
+    } else {
+        return nil, false
+    }
+}
+
+// ToList converts List to gofun.List that is gofun.Foldable.
+func ToList[T any](xs *List[T]) *gofun.List {
+    var ys *gofun.List = gofun.Nil()
+    var prev *gofun.List = nil
+    for l := xs; l.isCons; l = l.tail {
+        l2 := gofun.Cons(l.head, gofun.Nil())
+        if prev != nil {
+            prev.SetTail(l2)
+        } else {
+            ys = l2
+        }
+        prev = l2
+    }
+    return ys
+}
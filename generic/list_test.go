@@ -0,0 +1,174 @@
+/*
+ * Copyright (c) 2020 Łukasz Szpakowski
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in
+ * all copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+ * THE SOFTWARE.
+ */
+
+package generic_test
+import (
+    "reflect"
+    "testing"
+    "gofun"
+    . "gofun/generic"
+)
+
+func TestListConsFunctionDoesNotChangeSharedList(t *testing.T) {
+    xs := ListOf(2, 3)
+    ys := Cons(1, xs)
+    zs := Cons(4, xs)
+    if !reflect.DeepEqual(ys, ListOf(1, 2, 3)) {
+        t.Errorf("Cons function result is %v; want %v", ys, ListOf(1, 2, 3))
+    }
+    if !reflect.DeepEqual(zs, ListOf(4, 2, 3)) {
+        t.Errorf("Cons function result is %v; want %v", zs, ListOf(4, 2, 3))
+    }
+    if ys.Tail() != zs.Tail() {
+        t.Errorf("Cons function results don't share tail")
+    }
+}
+
+func TestListConcatMethodConcatenatesListAndList(t *testing.T) {
+    ys := ListOf(3, 4)
+    xs := ListOf(1, 2).Concat(ys)
+    if !reflect.DeepEqual(xs, ListOf(1, 2, 3, 4)) {
+        t.Errorf("List.Concat method result is %v; want %v", xs, ListOf(1, 2, 3, 4))
+    }
+    if xs.Drop(2) != ys {
+        t.Errorf("List.Concat method result doesn't share second list")
+    }
+}
+
+func TestListFilterMethodFiltersList(t *testing.T) {
+    xs := ListOf(1, 2, 3, 4, 6, 8)
+    ys := xs.Filter(func(x int) bool { return x % 2 == 0 })
+    if !reflect.DeepEqual(ys, ListOf(2, 4, 6, 8)) {
+        t.Errorf("List.Filter method result is %v; want %v", ys, ListOf(2, 4, 6, 8))
+    }
+    if ys.Drop(1) != xs.Drop(3) {
+        t.Errorf("List.Filter method result doesn't share tail")
+    }
+}
+
+func TestListFilterMethodFiltersListWithoutElements(t *testing.T) {
+    xs := ListOf(1, 3, 5).Filter(func(x int) bool { return x % 2 == 0 })
+    if !reflect.DeepEqual(xs, Nil[int]()) {
+        t.Errorf("List.Filter method result is %v; want %v", xs, Nil[int]())
+    }
+}
+
+func TestListReverseMethodReversesList(t *testing.T) {
+    xs := ListOf(1, 2, 3).Reverse()
+    if !reflect.DeepEqual(xs, ListOf(3, 2, 1)) {
+        t.Errorf("List.Reverse method result is %v; want %v", xs, ListOf(3, 2, 1))
+    }
+}
+
+func TestListTakeMethodTakesElements(t *testing.T) {
+    xs := ListOf(1, 2, 3).Take(2)
+    if !reflect.DeepEqual(xs, ListOf(1, 2)) {
+        t.Errorf("List.Take method result is %v; want %v", xs, ListOf(1, 2))
+    }
+}
+
+func TestListTakeMethodReturnsListForTooLargeNumber(t *testing.T) {
+    xs := ListOf(1, 2, 3)
+    ys := xs.Take(5)
+    if ys != xs {
+        t.Errorf("List.Take method result is %v; want same list", ys)
+    }
+}
+
+func TestListDropMethodDropsElements(t *testing.T) {
+    xs := ListOf(1, 2, 3).Drop(2)
+    if !reflect.DeepEqual(xs, ListOf(3)) {
+        t.Errorf("List.Drop method result is %v; want %v", xs, ListOf(3))
+    }
+}
+
+func TestListMapFunctionMapsList(t *testing.T) {
+    xs := ListMap(ListOf(1, 2, 3), func(x int) string { return string(rune('a' + x)) })
+    if !reflect.DeepEqual(xs, ListOf("b", "c", "d")) {
+        t.Errorf("ListMap function result is %v; want %v", xs, ListOf("b", "c", "d"))
+    }
+}
+
+func TestListFlatMapFunctionFlatMapsList(t *testing.T) {
+    xs := ListFlatMap(ListOf(1, 2), func(x int) *List[int] { return ListOf(x, x * 10) })
+    if !reflect.DeepEqual(xs, ListOf(1, 10, 2, 20)) {
+        t.Errorf("ListFlatMap function result is %v; want %v", xs, ListOf(1, 10, 2, 20))
+    }
+}
+
+func TestListFoldLeftFunctionFoldsList(t *testing.T) {
+    x := ListFoldLeft(ListOf("a", "b", "c"), func(x string, y string) string { return x + y }, "z")
+    if x != "zabc" {
+        t.Errorf("ListFoldLeft function result is %v; want %v", x, "zabc")
+    }
+}
+
+func TestListFoldRightFunctionFoldsList(t *testing.T) {
+    x := ListFoldRight(ListOf("a", "b", "c"), func(y string, x string) string { return x + y }, "z")
+    if x != "zcba" {
+        t.Errorf("ListFoldRight function result is %v; want %v", x, "zcba")
+    }
+}
+
+func TestFromListFunctionConvertsList(t *testing.T) {
+    xs, isOk := FromList[int](gofun.Cons(1, gofun.Cons(2, gofun.Nil())))
+    if !isOk {
+        t.Errorf("FromList function second result is %v; want %v", isOk, true)
+    }
+    if !reflect.DeepEqual(xs, ListOf(1, 2)) {
+        t.Errorf("FromList function first result is %v; want %v", xs, ListOf(1, 2))
+    }
+}
+
+func TestFromListFunctionConvertsInterfaceSlice(t *testing.T) {
+    xs, isOk := FromList[int](gofun.InterfaceSlice([]interface{} { 1, 2 }))
+    if !isOk {
+        t.Errorf("FromList function second result is %v; want %v", isOk, true)
+    }
+    if !reflect.DeepEqual(xs, ListOf(1, 2)) {
+        t.Errorf("FromList function first result is %v; want %v", xs, ListOf(1, 2))
+    }
+}
+
+func TestFromListFunctionDoesNotConvertListWithOtherType(t *testing.T) {
+    _, isOk := FromList[int](gofun.Cons(1, gofun.Cons("a", gofun.Nil())))
+    if isOk {
+        t.Errorf("FromList function second result is %v; want %v", isOk, false)
+    }
+}
+
+func TestFromListFunctionConvertsListWithNilPointers(t *testing.T) {
+    xs, isOk := FromList[*int](gofun.Cons(nil, gofun.Cons(nil, gofun.Nil())))
+    if !isOk {
+        t.Errorf("FromList function second result is %v; want %v", isOk, true)
+    }
+    if !reflect.DeepEqual(xs, ListOf[*int](nil, nil)) {
+        t.Errorf("FromList function first result is %v; want %v", xs, ListOf[*int](nil, nil))
+    }
+}
+
+func TestToListFunctionConvertsList(t *testing.T) {
+    xs := ToList(ListOf(1, 2))
+    if !reflect.DeepEqual(xs, gofun.Cons(1, gofun.Cons(2, gofun.Nil()))) {
+        t.Errorf("ToList function result is %v; want %v", xs, gofun.Cons(1, gofun.Cons(2, gofun.Nil())))
+    }
+}
@@ -0,0 +1,145 @@
+/*
+ * Copyright (c) 2020 Łukasz Szpakowski
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in
+ * all copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+ * THE SOFTWARE.
+ */
+
+// Package generic provides type-safe counterparts of the gofun types that use type parameters
+// instead of interface{}.
+package generic
+import (
+    "fmt"
+    "gofun"
+    "reflect"
+)
+
+// Option represents optional values of type T.
+type Option[T any] struct {
+    isSome bool
+    x T
+}
+
+// None creates an Option without a value.
+func None[T any]() *Option[T] {
+    return &Option[T] { isSome: false }
+}
+
+// Some creates an Option with a value.
+func Some[T any](x T) *Option[T] {
+    return &Option[T] { isSome: true, x: x }
+}
+
+// IsNone returns true if o doesn't contain the value, otherwise false.
+func (o *Option[T]) IsNone() bool {
+    return !o.isSome
+}
+
+// IsSome returns true if o contains the value, otherwise false.
+func (o *Option[T]) IsSome() bool {
+    return o.isSome
+}
+
+// Get returns the value. If o doesn't contain the value, Get returns the zero value of T.
+func (o *Option[T]) Get() T {
+    return o.x
+}
+
+// GetOrElse returns the value if o contains the value, otherwise x().
+func (o *Option[T]) GetOrElse(x func() T) T {
+    if o.isSome {
+        return o.x
+    } else {
+        return x()
+    }
+}
+
+// OrElse returns o if o contains the value, otherwise o2().
+func (o *Option[T]) OrElse(o2 func() *Option[T]) *Option[T] {
+    if o.isSome {
+        return o
+    } else {
+        return o2()
+    }
+}
+
+func (o *Option[T]) String() string {
+    if o.isSome {
+        return fmt.Sprintf("Some[%v]", o.x)
+    } else {
+        return "None"
+    }
+}
+
+// OptionMap maps the value of o by f.
+func OptionMap[T, U any](o *Option[T], f func(T) U) *Option[U] {
+    if o.isSome {
+        return Some(f(o.x))
+    } else {
+        return None[U]()
+    }
+}
+
+// OptionFlatMap maps the value of o by f and flattens the result.
+func OptionFlatMap[T, U any](o *Option[T], f func(T) *Option[U]) *Option[U] {
+    if o.isSome {
+        return f(o.x)
+    } else {
+        return None[U]()
+    }
+}
+
+// cast returns x as T. Nil is converted to the zero value of T if T can be nil.
+func cast[T any](x interface{}) (T, bool) {
+    if x == nil {
+        var z T
+        switch reflect.TypeOf(&z).Elem().Kind() {
+        case reflect.Interface, reflect.Pointer, reflect.Map, reflect.Slice, reflect.Func, reflect.Chan, reflect.UnsafePointer:
+            return z, true
+        default:
+            return z, false
+        }
+    }
+    y, isOk := x.(T)
+    return y, isOk
+}
+
+// FromOption converts gofun.Option to Option. The second result is false if the value of o isn't
+// T; in that case the first result is None. Nil is converted to the zero value of T if T can be
+// nil.
+func FromOption[T any](o *gofun.Option) (*Option[T], bool) {
+    if o.IsSome() {
+        x, isOk := cast[T](o.Get())
+        if isOk {
+            return Some(x), true
+        } else {
+            return None[T](), false
+        }
+    } else {
+        return None[T](), true
+    }
+}
+
+// ToOption converts Option to gofun.Option.
+func ToOption[T any](o *Option[T]) *gofun.Option {
+    if o.isSome {
+        return gofun.Some(o.x)
+    } else {
+        return gofun.None()
+    }
+}
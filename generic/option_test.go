@@ -0,0 +1,148 @@
+/*
+ * Copyright (c) 2020 Łukasz Szpakowski
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in
+ * all copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+ * THE SOFTWARE.
+ */
+
+package generic_test
+import (
+    "reflect"
+    "testing"
+    "gofun"
+    . "gofun/generic"
+)
+
+func TestOptionGetOrElseMethodReturnsValueForSome(t *testing.T) {
+    x := Some(2).GetOrElse(func() int { return 0 })
+    if x != 2 {
+        t.Errorf("Option.GetOrElse method result is %v; want %v", x, 2)
+    }
+}
+
+func TestOptionGetOrElseMethodReturnsDefaultValueForNone(t *testing.T) {
+    x := None[int]().GetOrElse(func() int { return 3 })
+    if x != 3 {
+        t.Errorf("Option.GetOrElse method result is %v; want %v", x, 3)
+    }
+}
+
+func TestOptionOrElseMethodReturnsOptionForSome(t *testing.T) {
+    o := Some(2).OrElse(func() *Option[int] { return Some(3) })
+    if !reflect.DeepEqual(o, Some(2)) {
+        t.Errorf("Option.OrElse method result is %v; want %v", o, Some(2))
+    }
+}
+
+func TestOptionOrElseMethodReturnsOtherOptionForNone(t *testing.T) {
+    o := None[int]().OrElse(func() *Option[int] { return Some(3) })
+    if !reflect.DeepEqual(o, Some(3)) {
+        t.Errorf("Option.OrElse method result is %v; want %v", o, Some(3))
+    }
+}
+
+func TestOptionMapFunctionMapsNone(t *testing.T) {
+    o := OptionMap(None[int](), func(x int) string { return "x" })
+    if !reflect.DeepEqual(o, None[string]()) {
+        t.Errorf("OptionMap function result is %v; want %v", o, None[string]())
+    }
+}
+
+func TestOptionMapFunctionMapsSome(t *testing.T) {
+    o := OptionMap(Some(2), func(x int) string { return "x" })
+    if !reflect.DeepEqual(o, Some("x")) {
+        t.Errorf("OptionMap function result is %v; want %v", o, Some("x"))
+    }
+}
+
+func TestOptionFlatMapFunctionFlatMapsSome(t *testing.T) {
+    o := OptionFlatMap(Some(2), func(x int) *Option[int] {
+            if x > 1 {
+                return Some(x + 1)
+            } else {
+                return None[int]()
+            }
+    })
+    if !reflect.DeepEqual(o, Some(3)) {
+        t.Errorf("OptionFlatMap function result is %v; want %v", o, Some(3))
+    }
+}
+
+func TestFromOptionFunctionConvertsSome(t *testing.T) {
+    o, isOk := FromOption[int](gofun.Some(2))
+    if !isOk {
+        t.Errorf("FromOption function second result is %v; want %v", isOk, true)
+    }
+    if !reflect.DeepEqual(o, Some(2)) {
+        t.Errorf("FromOption function first result is %v; want %v", o, Some(2))
+    }
+}
+
+func TestFromOptionFunctionDoesNotConvertSomeWithOtherType(t *testing.T) {
+    o, isOk := FromOption[int](gofun.Some("a"))
+    if isOk {
+        t.Errorf("FromOption function second result is %v; want %v", isOk, false)
+    }
+    if !reflect.DeepEqual(o, None[int]()) {
+        t.Errorf("FromOption function first result is %v; want %v", o, None[int]())
+    }
+}
+
+func TestFromOptionFunctionConvertsSomeWithNilPointer(t *testing.T) {
+    o, isOk := FromOption[*int](gofun.Some(nil))
+    if !isOk {
+        t.Errorf("FromOption function second result is %v; want %v", isOk, true)
+    }
+    if !reflect.DeepEqual(o, Some[*int](nil)) {
+        t.Errorf("FromOption function first result is %v; want %v", o, Some[*int](nil))
+    }
+}
+
+func TestFromOptionFunctionConvertsSomeWithNilInterface(t *testing.T) {
+    o, isOk := FromOption[error](gofun.Some(nil))
+    if !isOk {
+        t.Errorf("FromOption function second result is %v; want %v", isOk, true)
+    }
+    if !reflect.DeepEqual(o, Some[error](nil)) {
+        t.Errorf("FromOption function first result is %v; want %v", o, Some[error](nil))
+    }
+}
+
+func TestFromOptionFunctionDoesNotConvertSomeWithNilToInt(t *testing.T) {
+    o, isOk := FromOption[int](gofun.Some(nil))
+    if isOk {
+        t.Errorf("FromOption function second result is %v; want %v", isOk, false)
+    }
+    if !reflect.DeepEqual(o, None[int]()) {
+        t.Errorf("FromOption function first result is %v; want %v", o, None[int]())
+    }
+}
+
+func TestToOptionFunctionConvertsSome(t *testing.T) {
+    o := ToOption(Some(2))
+    if !reflect.DeepEqual(o, gofun.Some(2)) {
+        t.Errorf("ToOption function result is %v; want %v", o, gofun.Some(2))
+    }
+}
+
+func TestToOptionFunctionConvertsNone(t *testing.T) {
+    o := ToOption(None[int]())
+    if !reflect.DeepEqual(o, gofun.None()) {
+        t.Errorf("ToOption function result is %v; want %v", o, gofun.None())
+    }
+}
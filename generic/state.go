@@ -0,0 +1,156 @@
+/*
+ * Copyright (c) 2020 Łukasz Szpakowski
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in
+ * all copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+ * THE SOFTWARE.
+ */
+
+package generic
+import (
+    "fmt"
+    "gofun"
+    "reflect"
+)
+
+// State represents state monads where the state has type S and the result has type A.
+type State[S, A any] func(S) (S, A)
+
+// StateUnit is an unit function for State.
+func StateUnit[S, A any](x A) State[S, A] {
+    return State[S, A](func(s S) (S, A) {
+            return s, x
+    })
+}
+
+// GetState returns the State monad with the state.
+func GetState[S any]() State[S, S] {
+    return State[S, S](func(s S) (S, S) {
+            return s, s
+    })
+}
+
+// GetsState returns the State monad with the result of f for the state.
+func GetsState[S, A any](f func(S) A) State[S, A] {
+    return State[S, A](func(s S) (S, A) {
+            return s, f(s)
+    })
+}
+
+// PutState sets a new state.
+func PutState[S any](newS S) State[S, struct{}] {
+    return State[S, struct{}](func(s S) (S, struct{}) {
+            return newS, struct{} {}
+    })
+}
+
+// ModifyState sets a new state that is the result of f for the old state.
+func ModifyState[S any](f func(S) S) State[S, struct{}] {
+    return State[S, struct{}](func(s S) (S, struct{}) {
+            return f(s), struct{} {}
+    })
+}
+
+// RunState runs the State monad and returns the new state and the result.
+func RunState[S, A any](st State[S, A], s S) (S, A) {
+    return st(s)
+}
+
+// EvalState runs the State monad and returns the result.
+func EvalState[S, A any](st State[S, A], s S) A {
+    _, x := st(s)
+    return x
+}
+
+// ExecState runs the State monad and returns the new state.
+func ExecState[S, A any](st State[S, A], s S) S {
+    s2, _ := st(s)
+    return s2
+}
+
+// StateMap maps the result of st by f.
+func StateMap[S, A, B any](st State[S, A], f func(A) B) State[S, B] {
+    return State[S, B](func(s S) (S, B) {
+            s2, x := st(s)
+            return s2, f(x)
+    })
+}
+
+// StateFlatMap binds st and a function that returns State.
+func StateFlatMap[S, A, B any](st State[S, A], f func(A) State[S, B]) State[S, B] {
+    return State[S, B](func(s S) (S, B) {
+            s2, x := st(s)
+            return f(x)(s2)
+    })
+}
+
+// StateUntilM is a loop of until type for State. Unlike gofun.UntilM, the loop doesn't grow the
+// stack.
+func StateUntilM[S, A any](st State[S, A], cond func() State[S, bool]) State[S, struct{}] {
+    return State[S, struct{}](func(s S) (S, struct{}) {
+            for {
+                s, _ = st(s)
+                var isDone bool
+                s, isDone = cond()(s)
+                if isDone {
+                    return s, struct{} {}
+                }
+            }
+    })
+}
+
+// StateWhileM is a loop of while type for State. Unlike gofun.WhileM, the loop doesn't grow the
+// stack.
+func StateWhileM[S, A any](cond State[S, bool], body func() State[S, A]) State[S, struct{}] {
+    return State[S, struct{}](func(s S) (S, struct{}) {
+            for {
+                var isCont bool
+                s, isCont = cond(s)
+                if !isCont {
+                    return s, struct{} {}
+                }
+                s, _ = body()(s)
+            }
+    })
+}
+
+// mustCast returns x as T or panics with the description of the type mismatch. Nil is converted
+// to the zero value of T if T can be nil.
+func mustCast[T any](x interface{}, name string) T {
+    y, isOk := cast[T](x)
+    if !isOk {
+        panic(fmt.Sprintf("generic: %s has type %T; want %v", name, x, reflect.TypeOf((*T)(nil)).Elem()))
+    }
+    return y
+}
+
+// FromST converts gofun.ST to State. The returned State panics if st returns the state that isn't
+// S or the result that isn't A.
+func FromST[S, A any](st gofun.ST) State[S, A] {
+    return State[S, A](func(s S) (S, A) {
+            s2, x := gofun.RunST(st, s)
+            return mustCast[S](s2, "ST state"), mustCast[A](x, "ST result")
+    })
+}
+
+// ToST converts State to gofun.ST. The returned ST panics if it is run with the state that isn't
+// S.
+func ToST[S, A any](st State[S, A]) gofun.ST {
+    return gofun.NewST(func(s interface{}) (interface{}, interface{}) {
+            return st(mustCast[S](s, "ST state"))
+    })
+}
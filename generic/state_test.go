@@ -0,0 +1,121 @@
+/*
+ * Copyright (c) 2020 Łukasz Szpakowski
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in
+ * all copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+ * THE SOFTWARE.
+ */
+
+package generic_test
+import (
+    "reflect"
+    "testing"
+    "gofun"
+    . "gofun/generic"
+)
+
+func TestStateFlatMapFunctionBindsState(t *testing.T) {
+    st := StateFlatMap(GetState[int](), func(x int) State[int, string] {
+            return StateMap(PutState(x + 1), func(y struct{}) string { return "a" })
+    })
+    s, x := RunState(st, 1)
+    if s != 2 {
+        t.Errorf("RunState function first result is %v; want %v", s, 2)
+    }
+    if x != "a" {
+        t.Errorf("RunState function second result is %v; want %v", x, "a")
+    }
+}
+
+func TestGetsStateFunctionReturnsResultOfFunction(t *testing.T) {
+    x := EvalState(GetsState(func(s int) bool { return s > 1 }), 2)
+    if x != true {
+        t.Errorf("EvalState function result is %v; want %v", x, true)
+    }
+}
+
+func TestModifyStateFunctionModifiesState(t *testing.T) {
+    s := ExecState(ModifyState(func(s int) int { return s * 2 }), 3)
+    if s != 6 {
+        t.Errorf("ExecState function result is %v; want %v", s, 6)
+    }
+}
+
+func TestStateWhileMFunctionLoopsManyTimes(t *testing.T) {
+    st := StateWhileM(GetsState(func(s int) bool { return s < 1000000 }), func() State[int, struct{}] {
+            return ModifyState(func(s int) int { return s + 1 })
+    })
+    s := ExecState(st, 0)
+    if s != 1000000 {
+        t.Errorf("ExecState function result is %v; want %v", s, 1000000)
+    }
+}
+
+func TestStateUntilMFunctionLoops(t *testing.T) {
+    st := StateUntilM(ModifyState(func(s int) int { return s + 2 }), func() State[int, bool] {
+            return GetsState(func(s int) bool { return s >= 5 })
+    })
+    s := ExecState(st, 0)
+    if s != 6 {
+        t.Errorf("ExecState function result is %v; want %v", s, 6)
+    }
+}
+
+func TestFromSTFunctionConvertsST(t *testing.T) {
+    st := FromST[int, string](gofun.NewST(func(s interface{}) (interface{}, interface{}) {
+            return gofun.IntOrElse(s, 0) + 1, "a"
+    }))
+    s, x := RunState(st, 1)
+    if s != 2 {
+        t.Errorf("RunState function first result is %v; want %v", s, 2)
+    }
+    if x != "a" {
+        t.Errorf("RunState function second result is %v; want %v", x, "a")
+    }
+}
+
+func TestFromSTFunctionPanicsForResultWithOtherType(t *testing.T) {
+    defer func() {
+        if recover() == nil {
+            t.Errorf("RunState function doesn't panic")
+        }
+    }()
+    st := FromST[int, string](gofun.STUnit(2).(gofun.ST))
+    RunState(st, 1)
+}
+
+func TestFromSTFunctionConvertsNilState(t *testing.T) {
+    st := FromST[*int, string](gofun.STUnit("a").(gofun.ST))
+    s, x := RunState(st, nil)
+    if s != nil {
+        t.Errorf("RunState function first result is %v; want %v", s, nil)
+    }
+    if x != "a" {
+        t.Errorf("RunState function second result is %v; want %v", x, "a")
+    }
+}
+
+func TestToSTFunctionConvertsState(t *testing.T) {
+    st := ToST(StateMap(GetState[int](), func(x int) int { return x + 1 }))
+    s, x := gofun.RunST(st, 1)
+    if !reflect.DeepEqual(s, 1) {
+        t.Errorf("RunST function first result is %v; want %v", s, 1)
+    }
+    if !reflect.DeepEqual(x, 2) {
+        t.Errorf("RunST function second result is %v; want %v", x, 2)
+    }
+}
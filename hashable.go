@@ -0,0 +1,178 @@
+/*
+ * Copyright (c) 2020 Łukasz Szpakowski
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in
+ * all copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+ * THE SOFTWARE.
+ */
+
+package gofun
+import (
+    "math"
+    "reflect"
+)
+
+// Hashable is the interface for values that have a structural hash. Values that are equal by the
+// Equal function must have same hash.
+type Hashable interface {
+    // Hash returns the hash of the value.
+    Hash() uint64
+}
+
+// HashableOrElse returns x if x is Hashable, otherwise y.
+func HashableOrElse(x interface{}, y Hashable) Hashable {
+    z, isOk := x.(Hashable)
+    if isOk {
+        return z
+    } else {
+        return y
+    }
+}
+
+const (
+    hashOffset uint64 = 14695981039346656037
+    hashPrime uint64 = 1099511628211
+)
+
+func hashCombine(h, x uint64) uint64 {
+    return (h ^ x) * hashPrime
+}
+
+// Hash returns the hash of x. If x is Hashable, Hash uses the Hash method; otherwise Hash
+// calculates the hash from the value for comparable values and returns zero for other values.
+func Hash(x interface{}) uint64 {
+    x2, isOk := x.(Hashable)
+    if isOk {
+        return x2.Hash()
+    }
+    if x == nil {
+        return hashOffset
+    }
+    return hashValue(reflect.ValueOf(x))
+}
+
+func hashValue(v reflect.Value) uint64 {
+    h := hashCombine(hashOffset, uint64(v.Kind()))
+    switch v.Kind() {
+    case reflect.Bool:
+        if v.Bool() {
+            return hashCombine(h, 1)
+        } else {
+            return hashCombine(h, 0)
+        }
+    case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+        return hashCombine(h, uint64(v.Int()))
+    case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+        return hashCombine(h, v.Uint())
+    case reflect.Float32, reflect.Float64:
+        return hashCombine(h, hashFloat(v.Float()))
+    case reflect.Complex64, reflect.Complex128:
+        c := v.Complex()
+        return hashCombine(hashCombine(h, hashFloat(real(c))), hashFloat(imag(c)))
+    case reflect.String:
+        for _, b := range []byte(v.String()) {
+            h = hashCombine(h, uint64(b))
+        }
+        return h
+    case reflect.Ptr, reflect.Chan, reflect.UnsafePointer:
+        return hashCombine(h, uint64(v.Pointer()))
+    case reflect.Interface:
+        if v.IsNil() {
+            return h
+        } else if v.Elem().CanInterface() {
+            return hashCombine(h, Hash(v.Elem().Interface()))
+        } else {
+            return hashCombine(h, hashValue(v.Elem()))
+        }
+    case reflect.Array:
+        for i := 0; i < v.Len(); i++ {
+            h = hashCombine(h, hashValue(v.Index(i)))
+        }
+        return h
+    case reflect.Struct:
+        for i := 0; i < v.NumField(); i++ {
+            h = hashCombine(h, hashValue(v.Field(i)))
+        }
+        return h
+    default:
+        return 0
+    }
+}
+
+func hashFloat(x float64) uint64 {
+    if x == 0 {
+        return 0
+    } else {
+        return math.Float64bits(x)
+    }
+}
+
+func (p *Pair) Hash() uint64 {
+    if p == nil {
+        return 0
+    }
+    return hashCombine(hashCombine(hashOffset, Hash(p.First)), Hash(p.Second))
+}
+
+func (o *Option) Hash() uint64 {
+    if o == nil {
+        return 0
+    }
+    if o.isSome {
+        return hashCombine(hashCombine(hashOffset, 1), Hash(o.x))
+    } else {
+        return hashCombine(hashOffset, 0)
+    }
+}
+
+func (e *Either) Hash() uint64 {
+    if e == nil {
+        return 0
+    }
+    if e.isRight {
+        return hashCombine(hashCombine(hashOffset, 3), Hash(e.x))
+    } else {
+        return hashCombine(hashCombine(hashOffset, 2), Hash(e.x))
+    }
+}
+
+func (l *List) Hash() uint64 {
+    if l == nil {
+        return 0
+    }
+    h := hashOffset
+    for l2 := l; l2.isCons; l2 = l2.tail {
+        h = hashCombine(h, Hash(l2.head))
+    }
+    return h
+}
+
+func (xs InterfaceSlice) Hash() uint64 {
+    h := hashOffset
+    for _, x := range xs {
+        h = hashCombine(h, Hash(x))
+    }
+    return h
+}
+
+func (xs InterfacePairMap) Hash() uint64 {
+    var h uint64 = 0
+    for k, v := range xs {
+        h += hashCombine(hashCombine(hashOffset, Hash(k)), Hash(v))
+    }
+    return h
+}
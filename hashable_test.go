@@ -0,0 +1,120 @@
+/*
+ * Copyright (c) 2020 Łukasz Szpakowski
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in
+ * all copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+ * THE SOFTWARE.
+ */
+
+package gofun_test
+import (
+    "reflect"
+    "testing"
+    . "gofun"
+)
+
+func TestHashFunctionReturnsSameHashForEqualPairs(t *testing.T) {
+    h1 := Hash(NewPair(1, Cons("a", Nil())))
+    h2 := Hash(NewPair(1, Cons("a", Nil())))
+    if h1 != h2 {
+        t.Errorf("Hash function results are %v and %v; want same results", h1, h2)
+    }
+}
+
+func TestHashFunctionHashesNilPair(t *testing.T) {
+    var p *Pair = nil
+    h1 := Hash(p)
+    h2 := Hash(p)
+    if h1 != h2 {
+        t.Errorf("Hash function results are %v and %v; want same results", h1, h2)
+    }
+}
+
+func TestHashFunctionReturnsSameHashForEqualInterfacePairMaps(t *testing.T) {
+    h1 := Hash(InterfacePairMap(map[interface{}]interface{} { "a": 1, "b": Some(2) }))
+    h2 := Hash(InterfacePairMap(map[interface{}]interface{} { "b": Some(2), "a": 1 }))
+    if h1 != h2 {
+        t.Errorf("Hash function results are %v and %v; want same results", h1, h2)
+    }
+}
+
+func TestHashFunctionReturnsDifferentHashesForDifferentLists(t *testing.T) {
+    h1 := Hash(Cons(1, Cons(2, Nil())))
+    h2 := Hash(Cons(2, Cons(1, Nil())))
+    if h1 == h2 {
+        t.Errorf("Hash function results are %v and %v; want different results", h1, h2)
+    }
+}
+
+func TestHashPairMapSetMethodSetsValueForEqualPairKey(t *testing.T) {
+    m := NewHashPairMap()
+    m.Set(NewPair(1, 2), "a")
+    m.Set(NewPair(1, 2), "b")
+    if m.Len() != 1 {
+        t.Errorf("HashPairMap.Len method result is %v; want %v", m.Len(), 1)
+    }
+    o := m.Get(NewPair(1, 2))
+    if !reflect.DeepEqual(o, Some("b")) {
+        t.Errorf("HashPairMap.Get method result is %v; want %v", o, Some("b"))
+    }
+}
+
+func TestHashPairMapDeleteMethodDeletesPair(t *testing.T) {
+    m := NewHashPairMap()
+    m.Set(Some(1), "a")
+    m.Set(Some(2), "b")
+    b := m.Delete(Some(1))
+    if b != true {
+        t.Errorf("HashPairMap.Delete method result is %v; want %v", b, true)
+    }
+    o := m.Get(Some(1))
+    if !reflect.DeepEqual(o, None()) {
+        t.Errorf("HashPairMap.Get method result is %v; want %v", o, None())
+    }
+    if m.Len() != 1 {
+        t.Errorf("HashPairMap.Len method result is %v; want %v", m.Len(), 1)
+    }
+}
+
+func TestMapMethodMapsHashPairMapWithPairKeys(t *testing.T) {
+    m := NewHashPairMap()
+    m.Set(NewPair(1, 2), 1)
+    m.Set(NewPair(2, 1), 2)
+    xs := HashPairMapOrElse(m.Map(func(x interface{}) interface{} {
+            p := PairOrElse(x, NewPair(nil, 0))
+            return NewPair(NewPair(1, 2), IntOrElse(p.Second, 0))
+    }), NewHashPairMap())
+    if xs.Len() != 1 {
+        t.Errorf("HashPairMap.Len method result is %v; want %v", xs.Len(), 1)
+    }
+}
+
+func TestBindMethodBindsHashPairMap(t *testing.T) {
+    m := NewHashPairMap()
+    m.Set("a", 1)
+    m.Set("b", 2)
+    m2 := m.Bind(func(x interface{}) Monad {
+            p := PairOrElse(x, NewPair("", 0))
+            return HashPairMapUnit(NewPair(Some(StringOrElse(p.First, "")), IntOrElse(p.Second, 0) + 1))
+    })
+    ys := NewHashPairMap()
+    ys.Set(Some("a"), 2)
+    ys.Set(Some("b"), 3)
+    if !Equal(m2, ys) {
+        t.Errorf("Bind method result is %v; want %v", m2, ys)
+    }
+}
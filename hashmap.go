@@ -0,0 +1,330 @@
+/*
+ * Copyright (c) 2020 Łukasz Szpakowski
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in
+ * all copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+ * THE SOFTWARE.
+ */
+
+package gofun
+import (
+    "fmt"
+    "math/bits"
+)
+
+const (
+    hashMapBits = 5
+    hashMapMask = 1 << hashMapBits - 1
+)
+
+// hashMapEntry is an entry of HashMap with the hash of the key.
+type hashMapEntry struct {
+    hash uint64
+    key interface{}
+    value interface{}
+}
+
+// hashMapNode is a node of the trie of HashMap. The bitmap tells which of 32 children exist and
+// the children are hashMapEntry pointers or hashMapNode pointers. The nodes below the last level
+// of the hash bits contain the entries with the same hash in collisions.
+type hashMapNode struct {
+    bitmap uint32
+    children []interface{}
+    collisions []*hashMapEntry
+}
+
+// HashMap represents persistent maps of pairs. HashMap is a hash array mapped trie where the keys
+// are compared by the Hash and Equal functions, so two structurally equal keys are one key of
+// HashMap. The methods of HashMap don't change the map and the new map shares the unchanged nodes
+// with the old map, so HashMap can be safely shared between goroutines.
+type HashMap struct {
+    root *hashMapNode
+    size int
+}
+
+// HashMapOrElse returns x if x is HashMap pointer, otherwise y.
+func HashMapOrElse(x interface{}, y *HashMap) *HashMap {
+    return OrElse(x, y)
+}
+
+// NewHashMap creates an empty HashMap.
+func NewHashMap() *HashMap {
+    return &HashMap { root: &hashMapNode {}, size: 0 }
+}
+
+// Len returns the number of pairs.
+func (m *HashMap) Len() int {
+    return m.size
+}
+
+func (node *hashMapNode) index(bit uint32) int {
+    return bits.OnesCount32(node.bitmap & (bit - 1))
+}
+
+func (node *hashMapNode) isEmpty() bool {
+    return node.bitmap == 0 && len(node.collisions) == 0
+}
+
+// single returns the entry if the node contains only this entry, otherwise nil.
+func (node *hashMapNode) single() *hashMapEntry {
+    if len(node.collisions) == 1 {
+        return node.collisions[0]
+    } else if len(node.children) == 1 {
+        e, _ := node.children[0].(*hashMapEntry)
+        return e
+    } else {
+        return nil
+    }
+}
+
+// Lookup returns the optional value for the key.
+func (m *HashMap) Lookup(k interface{}) *Option {
+    h := Hash(k)
+    node := m.root
+    for shift := uint(0); ; shift += hashMapBits {
+        if shift >= 64 {
+            for _, e := range node.collisions {
+                if Equal(k, e.key) {
+                    return Some(e.value)
+                }
+            }
+            return None()
+        }
+        bit := uint32(1) << ((h >> shift) & hashMapMask)
+        if node.bitmap & bit == 0 {
+            return None()
+        }
+        switch child := node.children[node.index(bit)].(type) {
+        case *hashMapEntry:
+            if child.hash == h && Equal(k, child.key) {
+                return Some(child.value)
+            } else {
+                return None()
+            }
+        case *hashMapNode:
+            node = child
+        }
+    }
+}
+
+func hashMapMerge(shift uint, e, e2 *hashMapEntry) *hashMapNode {
+    if shift >= 64 {
+        return &hashMapNode { collisions: []*hashMapEntry { e, e2 } }
+    }
+    i := uint32((e.hash >> shift) & hashMapMask)
+    i2 := uint32((e2.hash >> shift) & hashMapMask)
+    if i == i2 {
+        return &hashMapNode { bitmap: 1 << i, children: []interface{} { hashMapMerge(shift + hashMapBits, e, e2) } }
+    } else if i < i2 {
+        return &hashMapNode { bitmap: 1 << i | 1 << i2, children: []interface{} { e, e2 } }
+    } else {
+        return &hashMapNode { bitmap: 1 << i | 1 << i2, children: []interface{} { e2, e } }
+    }
+}
+
+func hashMapAssoc(node *hashMapNode, shift uint, e *hashMapEntry) (*hashMapNode, bool) {
+    if shift >= 64 {
+        collisions := append([]*hashMapEntry {}, node.collisions...)
+        for i, e2 := range collisions {
+            if Equal(e.key, e2.key) {
+                collisions[i] = e
+                return &hashMapNode { collisions: collisions }, false
+            }
+        }
+        return &hashMapNode { collisions: append(collisions, e) }, true
+    }
+    bit := uint32(1) << ((e.hash >> shift) & hashMapMask)
+    i := node.index(bit)
+    if node.bitmap & bit == 0 {
+        children := make([]interface{}, 0, len(node.children) + 1)
+        children = append(children, node.children[:i]...)
+        children = append(children, e)
+        children = append(children, node.children[i:]...)
+        return &hashMapNode { bitmap: node.bitmap | bit, children: children }, true
+    }
+    children := append([]interface{} {}, node.children...)
+    isAdded := false
+    switch child := children[i].(type) {
+    case *hashMapEntry:
+        if child.hash == e.hash && Equal(e.key, child.key) {
+            children[i] = e
+        } else {
+            children[i] = hashMapMerge(shift + hashMapBits, child, e)
+            isAdded = true
+        }
+    case *hashMapNode:
+        children[i], isAdded = hashMapAssoc(child, shift + hashMapBits, e)
+    }
+    return &hashMapNode { bitmap: node.bitmap, children: children }, isAdded
+}
+
+func hashMapDissoc(node *hashMapNode, shift uint, h uint64, k interface{}) (*hashMapNode, bool) {
+    if shift >= 64 {
+        for i, e := range node.collisions {
+            if Equal(k, e.key) {
+                collisions := make([]*hashMapEntry, 0, len(node.collisions) - 1)
+                collisions = append(collisions, node.collisions[:i]...)
+                collisions = append(collisions, node.collisions[i + 1:]...)
+                return &hashMapNode { collisions: collisions }, true
+            }
+        }
+        return node, false
+    }
+    bit := uint32(1) << ((h >> shift) & hashMapMask)
+    if node.bitmap & bit == 0 {
+        return node, false
+    }
+    i := node.index(bit)
+    var child interface{}
+    switch child2 := node.children[i].(type) {
+    case *hashMapEntry:
+        if child2.hash != h || !Equal(k, child2.key) {
+            return node, false
+        }
+        child = nil
+    case *hashMapNode:
+        node2, isRemoved := hashMapDissoc(child2, shift + hashMapBits, h, k)
+        if !isRemoved {
+            return node, false
+        }
+        if node2.isEmpty() {
+            child = nil
+        } else if e := node2.single(); e != nil {
+            child = e
+        } else {
+            child = node2
+        }
+    }
+    if child != nil {
+        children := append([]interface{} {}, node.children...)
+        children[i] = child
+        return &hashMapNode { bitmap: node.bitmap, children: children }, true
+    } else {
+        children := make([]interface{}, 0, len(node.children) - 1)
+        children = append(children, node.children[:i]...)
+        children = append(children, node.children[i + 1:]...)
+        return &hashMapNode { bitmap: node.bitmap &^ bit, children: children }, true
+    }
+}
+
+// Assoc returns the map with the value for the key.
+func (m *HashMap) Assoc(k, v interface{}) *HashMap {
+    root, isAdded := hashMapAssoc(m.root, 0, &hashMapEntry { hash: Hash(k), key: k, value: v })
+    if isAdded {
+        return &HashMap { root: root, size: m.size + 1 }
+    } else {
+        return &HashMap { root: root, size: m.size }
+    }
+}
+
+// Dissoc returns the map without the pair for the key.
+func (m *HashMap) Dissoc(k interface{}) *HashMap {
+    root, isRemoved := hashMapDissoc(m.root, 0, Hash(k), k)
+    if isRemoved {
+        return &HashMap { root: root, size: m.size - 1 }
+    } else {
+        return m
+    }
+}
+
+// Update returns the map with the value that is returned by f for the optional value for the key.
+// If f returns None, Update returns the map without the pair for the key.
+func (m *HashMap) Update(k interface{}, f func(*Option) *Option) *HashMap {
+    o := f(m.Lookup(k))
+    if o.IsSome() {
+        return m.Assoc(k, o.Get())
+    } else {
+        return m.Dissoc(k)
+    }
+}
+
+// Merge returns the map with the pairs of m and m2. If both maps contain the key, the value is
+// returned by f for the value from m and the value from m2.
+func (m *HashMap) Merge(m2 *HashMap, f func(interface{}, interface{}) interface{}) *HashMap {
+    m3 := m
+    m2.root.each(func(e *hashMapEntry) bool {
+            o := m3.Lookup(e.key)
+            if o.IsSome() {
+                m3 = m3.Assoc(e.key, f(o.Get(), e.value))
+            } else {
+                m3 = m3.Assoc(e.key, e.value)
+            }
+            return true
+    })
+    return m3
+}
+
+// each calls f for the entries while f returns true. If f returns false, each also returns false.
+func (node *hashMapNode) each(f func(*hashMapEntry) bool) bool {
+    for _, e := range node.collisions {
+        if !f(e) {
+            return false
+        }
+    }
+    for _, child := range node.children {
+        switch child2 := child.(type) {
+        case *hashMapEntry:
+            if !f(child2) {
+                return false
+            }
+        case *hashMapNode:
+            if !child2.each(f) {
+                return false
+            }
+        }
+    }
+    return true
+}
+
+func (m *HashMap) String() string {
+    s := "HashMap["
+    isFirst := true
+    m.root.each(func(e *hashMapEntry) bool {
+            if !isFirst {
+                s += " "
+            }
+            s += fmt.Sprintf("%v:%v", e.key, e.value)
+            isFirst = false
+            return true
+    })
+    s += "]"
+    return s
+}
+
+func (m *HashMap) Equal(other interface{}) bool {
+    m2, isOk := other.(*HashMap)
+    if isOk {
+        if m.size != m2.size {
+            return false
+        }
+        return m.root.each(func(e *hashMapEntry) bool {
+                o := m2.Lookup(e.key)
+                return o.IsSome() && Equal(e.value, o.Get())
+        })
+    } else {
+        return false
+    }
+}
+
+func (m *HashMap) Hash() uint64 {
+    var h uint64 = 0
+    m.root.each(func(e *hashMapEntry) bool {
+            h += NewPair(e.key, e.value).Hash()
+            return true
+    })
+    return h
+}
@@ -0,0 +1,208 @@
+/*
+ * Copyright (c) 2020 Łukasz Szpakowski
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in
+ * all copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+ * THE SOFTWARE.
+ */
+
+package gofun_test
+import (
+    "reflect"
+    "testing"
+    . "gofun"
+)
+
+type collidingKey struct {
+    n int
+}
+
+func (k collidingKey) Hash() uint64 {
+    return 1
+}
+
+func rangeHashMap(n int) *HashMap {
+    m := NewHashMap()
+    for i := 0; i < n; i++ {
+        m = m.Assoc(i, i * 2)
+    }
+    return m
+}
+
+func TestHashMapAssocMethodAssociatesValues(t *testing.T) {
+    m := rangeHashMap(10000)
+    if m.Len() != 10000 {
+        t.Errorf("HashMap.Len method result is %v; want %v", m.Len(), 10000)
+    }
+    for i := 0; i < 10000; i++ {
+        o := m.Lookup(i)
+        if !reflect.DeepEqual(o, Some(i * 2)) {
+            t.Fatalf("HashMap.Lookup method result is %v; want %v", o, Some(i * 2))
+        }
+    }
+    if !reflect.DeepEqual(m.Lookup(10000), None()) {
+        t.Errorf("HashMap.Lookup method result is %v; want %v", m.Lookup(10000), None())
+    }
+}
+
+func TestHashMapAssocMethodDoesNotChangeMap(t *testing.T) {
+    m := rangeHashMap(100)
+    m2 := m.Assoc(1, "a").Assoc(100, "b")
+    if m.Len() != 100 {
+        t.Errorf("HashMap.Len method result is %v; want %v", m.Len(), 100)
+    }
+    if m2.Len() != 101 {
+        t.Errorf("HashMap.Len method result is %v; want %v", m2.Len(), 101)
+    }
+    if !reflect.DeepEqual(m.Lookup(1), Some(2)) {
+        t.Errorf("HashMap.Lookup method result is %v; want %v", m.Lookup(1), Some(2))
+    }
+    if !reflect.DeepEqual(m2.Lookup(1), Some("a")) {
+        t.Errorf("HashMap.Lookup method result is %v; want %v", m2.Lookup(1), Some("a"))
+    }
+}
+
+func TestHashMapLookupMethodFindsStructuralKey(t *testing.T) {
+    m := NewHashMap().Assoc(NewPair(1, "a"), 1).Assoc(NewPair(1, "a"), 2)
+    if m.Len() != 1 {
+        t.Errorf("HashMap.Len method result is %v; want %v", m.Len(), 1)
+    }
+    if !reflect.DeepEqual(m.Lookup(NewPair(1, "a")), Some(2)) {
+        t.Errorf("HashMap.Lookup method result is %v; want %v", m.Lookup(NewPair(1, "a")), Some(2))
+    }
+}
+
+func TestHashMapDissocMethodRemovesPairs(t *testing.T) {
+    m := rangeHashMap(1000)
+    m2 := m
+    for i := 0; i < 1000; i += 2 {
+        m2 = m2.Dissoc(i)
+    }
+    if m2.Len() != 500 {
+        t.Errorf("HashMap.Len method result is %v; want %v", m2.Len(), 500)
+    }
+    for i := 0; i < 1000; i++ {
+        o := m2.Lookup(i)
+        if i % 2 == 0 && o.IsSome() {
+            t.Fatalf("HashMap.Lookup method result is %v; want %v", o, None())
+        }
+        if i % 2 == 1 && !reflect.DeepEqual(o, Some(i * 2)) {
+            t.Fatalf("HashMap.Lookup method result is %v; want %v", o, Some(i * 2))
+        }
+    }
+    if m.Len() != 1000 {
+        t.Errorf("HashMap.Len method result is %v; want %v", m.Len(), 1000)
+    }
+    if m2.Dissoc(0).Len() != 500 {
+        t.Errorf("HashMap.Len method result is %v; want %v", m2.Dissoc(0).Len(), 500)
+    }
+}
+
+func TestHashMapMethodsHandleCollisions(t *testing.T) {
+    m := NewHashMap()
+    for i := 0; i < 10; i++ {
+        m = m.Assoc(collidingKey { i }, i)
+    }
+    m = m.Dissoc(collidingKey { 3 }).Assoc(collidingKey { 4 }, "a")
+    if m.Len() != 9 {
+        t.Errorf("HashMap.Len method result is %v; want %v", m.Len(), 9)
+    }
+    if !reflect.DeepEqual(m.Lookup(collidingKey { 3 }), None()) {
+        t.Errorf("HashMap.Lookup method result is %v; want %v", m.Lookup(collidingKey { 3 }), None())
+    }
+    if !reflect.DeepEqual(m.Lookup(collidingKey { 4 }), Some("a")) {
+        t.Errorf("HashMap.Lookup method result is %v; want %v", m.Lookup(collidingKey { 4 }), Some("a"))
+    }
+    if !reflect.DeepEqual(m.Lookup(collidingKey { 9 }), Some(9)) {
+        t.Errorf("HashMap.Lookup method result is %v; want %v", m.Lookup(collidingKey { 9 }), Some(9))
+    }
+}
+
+func TestHashMapUpdateMethodUpdatesValue(t *testing.T) {
+    f := func(o *Option) *Option {
+        if o.IsSome() {
+            if IntOrElse(o.Get(), 0) >= 2 {
+                return None()
+            }
+            return Some(IntOrElse(o.Get(), 0) + 1)
+        } else {
+            return Some(1)
+        }
+    }
+    m := NewHashMap().Update("a", f)
+    if !reflect.DeepEqual(m.Lookup("a"), Some(1)) {
+        t.Errorf("HashMap.Lookup method result is %v; want %v", m.Lookup("a"), Some(1))
+    }
+    m = m.Update("a", f)
+    if !reflect.DeepEqual(m.Lookup("a"), Some(2)) {
+        t.Errorf("HashMap.Lookup method result is %v; want %v", m.Lookup("a"), Some(2))
+    }
+    m = m.Update("a", f)
+    if m.Len() != 0 {
+        t.Errorf("HashMap.Len method result is %v; want %v", m.Len(), 0)
+    }
+}
+
+func TestHashMapMergeMethodMergesMaps(t *testing.T) {
+    m := NewHashMap().Assoc("a", 1).Assoc("b", 2).Merge(NewHashMap().Assoc("b", 3).Assoc("c", 4), add)
+    m2 := NewHashMap().Assoc("a", 1).Assoc("b", 5).Assoc("c", 4)
+    if !Equal(m, m2) {
+        t.Errorf("HashMap.Merge method result is %v; want %v", m, m2)
+    }
+}
+
+func TestHashMapMapMethodMapsPairs(t *testing.T) {
+    m := rangeHashMap(100).Map(func(x interface{}) interface{} {
+            p := PairOrElse(x, NewPair(nil, nil))
+            return NewPair(p.First, IntOrElse(p.Second, 0) + 1)
+    })
+    m2 := NewHashMap()
+    for i := 0; i < 100; i++ {
+        m2 = m2.Assoc(i, i * 2 + 1)
+    }
+    if !Equal(m, m2) {
+        t.Errorf("HashMap.Map method result is %v; want %v", m, m2)
+    }
+}
+
+func TestHashMapBindMethodBindsPairs(t *testing.T) {
+    m := NewHashMap().Assoc(1, "a").Assoc(2, "b").Bind(func(x interface{}) Monad {
+            p := PairOrElse(x, NewPair(nil, nil))
+            return HashMapUnit(p).(*HashMap).Assoc(IntOrElse(p.First, 0) * 10, p.Second)
+    })
+    m2 := NewHashMap().Assoc(1, "a").Assoc(2, "b").Assoc(10, "a").Assoc(20, "b")
+    if !Equal(m, m2) {
+        t.Errorf("HashMap.Bind method result is %v; want %v", m, m2)
+    }
+}
+
+func TestHashMapFoldLeftMethodFoldsPairs(t *testing.T) {
+    x := rangeHashMap(100).FoldLeft(func(x, y interface{}) interface{} {
+            return IntOrElse(x, 0) + IntOrElse(PairOrElse(y, NewPair(nil, 0)).Second, 0)
+    }, 0)
+    if !reflect.DeepEqual(x, 9900) {
+        t.Errorf("HashMap.FoldLeft method result is %v; want %v", x, 9900)
+    }
+}
+
+func TestHashMapHashMethodReturnsSameHashForEqualMaps(t *testing.T) {
+    m := rangeHashMap(100)
+    m2 := rangeHashMap(101).Dissoc(100)
+    if Hash(m) != Hash(m2) {
+        t.Errorf("Hash function result is %v; want %v", Hash(m), Hash(m2))
+    }
+}
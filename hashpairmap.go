@@ -0,0 +1,143 @@
+/*
+ * Copyright (c) 2020 Łukasz Szpakowski
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in
+ * all copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+ * THE SOFTWARE.
+ */
+
+package gofun
+import "fmt"
+
+// HashPairMap represents maps of pairs where the keys are compared by the Hash and Equal
+// functions instead of equal operator. Thus, two structurally equal keys, for example two equal
+// Pair pointers, are one key of HashPairMap.
+type HashPairMap struct {
+    buckets map[uint64][]*Pair
+    size int
+}
+
+// HashPairMapOrElse returns x if x is HashPairMap pointer, otherwise y.
+func HashPairMapOrElse(x interface{}, y *HashPairMap) *HashPairMap {
+    z, isOk := x.(*HashPairMap)
+    if isOk {
+        return z
+    } else {
+        return y
+    }
+}
+
+// NewHashPairMap creates an empty HashPairMap.
+func NewHashPairMap() *HashPairMap {
+    return &HashPairMap { buckets: make(map[uint64][]*Pair), size: 0 }
+}
+
+// Len returns the number of pairs.
+func (m *HashPairMap) Len() int {
+    return m.size
+}
+
+// Get returns the optional value for the key.
+func (m *HashPairMap) Get(k interface{}) *Option {
+    for _, p := range m.buckets[Hash(k)] {
+        if Equal(k, p.First) {
+            return Some(p.Second)
+        }
+    }
+    return None()
+}
+
+// Set sets the value for the key.
+func (m *HashPairMap) Set(k, v interface{}) {
+    h := Hash(k)
+    bucket := m.buckets[h]
+    for i, p := range bucket {
+        if Equal(k, p.First) {
+            bucket[i] = NewPair(p.First, v)
+            return
+        }
+    }
+    m.buckets[h] = append(bucket, NewPair(k, v))
+    m.size++
+}
+
+// Delete deletes the pair for the key. If Delete deletes the pair, this method returns true;
+// otherwise this method returns false.
+func (m *HashPairMap) Delete(k interface{}) bool {
+    h := Hash(k)
+    bucket := m.buckets[h]
+    for i, p := range bucket {
+        if Equal(k, p.First) {
+            if len(bucket) > 1 {
+                bucket2 := make([]*Pair, 0, len(bucket) - 1)
+                bucket2 = append(bucket2, bucket[:i]...)
+                m.buckets[h] = append(bucket2, bucket[i + 1:]...)
+            } else {
+                delete(m.buckets, h)
+            }
+            m.size--
+            return true
+        }
+    }
+    return false
+}
+
+func (m *HashPairMap) String() string {
+    s := "HashPairMap["
+    isFirst := true
+    for _, bucket := range m.buckets {
+        for _, p := range bucket {
+            if !isFirst {
+                s += " "
+            }
+            s += fmt.Sprintf("%v:%v", p.First, p.Second)
+            isFirst = false
+        }
+    }
+    s += "]"
+    return s
+}
+
+func (m *HashPairMap) Equal(other interface{}) bool {
+    m2, isOk := other.(*HashPairMap)
+    if isOk {
+        if m.size != m2.size {
+            return false
+        }
+        for _, bucket := range m.buckets {
+            for _, p := range bucket {
+                o := m2.Get(p.First)
+                if o.IsNone() || !Equal(p.Second, o.Get()) {
+                    return false
+                }
+            }
+        }
+        return true
+    } else {
+        return false
+    }
+}
+
+func (m *HashPairMap) Hash() uint64 {
+    var h uint64 = 0
+    for _, bucket := range m.buckets {
+        for _, p := range bucket {
+            h += p.Hash()
+        }
+    }
+    return h
+}
@@ -0,0 +1,87 @@
+/*
+ * Copyright (c) 2020 Łukasz Szpakowski
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in
+ * all copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+ * THE SOFTWARE.
+ */
+
+package gofun
+import (
+    "fmt"
+    "sync"
+    "sync/atomic"
+)
+
+// Lazy represents a value that is computed on demand. The value is computed at most once, also if
+// Lazy is forced by many goroutines at the same time. If the function that is passed to Bind
+// doesn't return Lazy, the value of the result is nil.
+type Lazy struct {
+    once sync.Once
+    isForced atomic.Bool
+    f func() interface{}
+    x interface{}
+    hasPanicked bool
+    panicValue interface{}
+}
+
+// LazyOrElse returns x if x is a pointer to Lazy, otherwise y.
+func LazyOrElse(x interface{}, y *Lazy) *Lazy {
+    return OrElse(x, y)
+}
+
+// Defer creates Lazy that computes the value by f. Lazy calls f at the first call of Force.
+func Defer(f func() interface{}) *Lazy {
+    return &Lazy { f: f }
+}
+
+// Force returns the value and computes it if it isn't computed. The Force method can be passed
+// to the GetOrElse methods, so the default value is computed only when it is needed. If the
+// computation panics, Force panics with the same value at this call and at every later call.
+func (l *Lazy) Force() interface{} {
+    l.once.Do(func() {
+            isDone := false
+            defer func() {
+                if !isDone {
+                    l.hasPanicked = true
+                    l.panicValue = recover()
+                    l.f = nil
+                }
+            }()
+            l.x = l.f()
+            l.f = nil
+            l.isForced.Store(true)
+            isDone = true
+    })
+    if l.hasPanicked {
+        panic(l.panicValue)
+    }
+    return l.x
+}
+
+// IsForced returns true if the value is computed, otherwise false.
+func (l *Lazy) IsForced() bool {
+    return l.isForced.Load()
+}
+
+func (l *Lazy) String() string {
+    if l.IsForced() {
+        return fmt.Sprintf("Lazy[%v]", l.x)
+    } else {
+        return "Lazy[<unforced>]"
+    }
+}
@@ -0,0 +1,194 @@
+/*
+ * Copyright (c) 2020 Łukasz Szpakowski
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in
+ * all copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+ * THE SOFTWARE.
+ */
+
+package gofun_test
+import (
+    "reflect"
+    "sync"
+    "testing"
+    . "gofun"
+)
+
+func TestDeferFunctionDoesNotComputeValue(t *testing.T) {
+    count := 0
+    l := Defer(func() interface{} {
+            count++
+            return 1
+    })
+    if count != 0 {
+        t.Errorf("number of calls is %v; want %v", count, 0)
+    }
+    if l.IsForced() != false {
+        t.Errorf("Lazy.IsForced method result is %v; want %v", l.IsForced(), false)
+    }
+}
+
+func TestLazyForceMethodComputesValueOnce(t *testing.T) {
+    count := 0
+    l := Defer(func() interface{} {
+            count++
+            return 1
+    })
+    x := l.Force()
+    x2 := l.Force()
+    if !reflect.DeepEqual(x, 1) {
+        t.Errorf("Lazy.Force method result is %v; want %v", x, 1)
+    }
+    if !reflect.DeepEqual(x2, 1) {
+        t.Errorf("Lazy.Force method result is %v; want %v", x2, 1)
+    }
+    if count != 1 {
+        t.Errorf("number of calls is %v; want %v", count, 1)
+    }
+    if l.IsForced() != true {
+        t.Errorf("Lazy.IsForced method result is %v; want %v", l.IsForced(), true)
+    }
+}
+
+func TestLazyForceMethodComputesValueOnceForManyGoroutines(t *testing.T) {
+    var mutex sync.Mutex
+    count := 0
+    l := Defer(func() interface{} {
+            mutex.Lock()
+            count++
+            mutex.Unlock()
+            return 1
+    })
+    var wg sync.WaitGroup
+    for i := 0; i < 100; i++ {
+        wg.Add(1)
+        go func() {
+            defer wg.Done()
+            l.Force()
+        }()
+    }
+    wg.Wait()
+    if count != 1 {
+        t.Errorf("number of calls is %v; want %v", count, 1)
+    }
+}
+
+func TestLazyForceMethodPanicsAtEveryCallAfterPanic(t *testing.T) {
+    n := 0
+    l := Defer(func() interface{} {
+            n++
+            panic("error")
+    })
+    for i := 0; i < 2; i++ {
+        func() {
+            defer func() {
+                r := recover()
+                if r != "error" {
+                    t.Errorf("Lazy.Force method panic value is %v; want %v", r, "error")
+                }
+            }()
+            l.Force()
+        }()
+    }
+    if n != 1 {
+        t.Errorf("number of calls is %v; want %v", n, 1)
+    }
+    if l.IsForced() != false {
+        t.Errorf("Lazy.IsForced method result is %v; want %v", l.IsForced(), false)
+    }
+}
+
+func TestLazyForceMethodIsDefaultForGetOrElse(t *testing.T) {
+    count := 0
+    l := Defer(func() interface{} {
+            count++
+            return 2
+    })
+    x := Some(1).GetOrElse(l.Force)
+    if !reflect.DeepEqual(x, 1) {
+        t.Errorf("Option.GetOrElse method result is %v; want %v", x, 1)
+    }
+    if count != 0 {
+        t.Errorf("number of calls is %v; want %v", count, 0)
+    }
+    x2 := None().GetOrElse(l.Force)
+    x3 := None().GetOrElse(l.Force)
+    if !reflect.DeepEqual(x2, 2) {
+        t.Errorf("Option.GetOrElse method result is %v; want %v", x2, 2)
+    }
+    if !reflect.DeepEqual(x3, 2) {
+        t.Errorf("Option.GetOrElse method result is %v; want %v", x3, 2)
+    }
+    if count != 1 {
+        t.Errorf("number of calls is %v; want %v", count, 1)
+    }
+}
+
+func TestLazyMapMethodMapsLazily(t *testing.T) {
+    count := 0
+    l := Defer(func() interface{} {
+            return 2
+    }).Map(func(x interface{}) interface{} {
+            count++
+            return IntOrElse(x, 0) + 1
+    }).(*Lazy)
+    if count != 0 {
+        t.Errorf("number of calls is %v; want %v", count, 0)
+    }
+    x := l.Force()
+    if !reflect.DeepEqual(x, 3) {
+        t.Errorf("Lazy.Force method result is %v; want %v", x, 3)
+    }
+}
+
+func TestLazyBindMethodBindsLazily(t *testing.T) {
+    count := 0
+    l := LazyUnit(2).Bind(func(x interface{}) Monad {
+            count++
+            return Defer(func() interface{} {
+                    return IntOrElse(x, 0) * 3
+            })
+    }).(*Lazy)
+    if count != 0 {
+        t.Errorf("number of calls is %v; want %v", count, 0)
+    }
+    x := l.Force()
+    if !reflect.DeepEqual(x, 6) {
+        t.Errorf("Lazy.Force method result is %v; want %v", x, 6)
+    }
+}
+
+func TestLazyUnitFunctionCreatesForcedLazy(t *testing.T) {
+    l := LazyUnit(1).(*Lazy)
+    if l.IsForced() != true {
+        t.Errorf("Lazy.IsForced method result is %v; want %v", l.IsForced(), true)
+    }
+    if !reflect.DeepEqual(l.Force(), 1) {
+        t.Errorf("Lazy.Force method result is %v; want %v", l.Force(), 1)
+    }
+}
+
+func TestLazyFoldLeftMethodFoldsLazy(t *testing.T) {
+    x := Defer(func() interface{} {
+            return 2
+    }).FoldLeft(func(x, y interface{}) interface{} {
+            return IntOrElse(x, 0) + IntOrElse(y, 0)
+    }, 1)
+    if !reflect.DeepEqual(x, 3) {
+        t.Errorf("Lazy.FoldLeft method result is %v; want %v", x, 3)
+    }
+}
@@ -60,7 +60,25 @@ func Join(m, fail Monad) Monad {
 }
 
 // UntilM is a loop of until type for monads. Unit must be the unit function for specified monad.
+// If the monad is MonadRec, UntilM doesn't grow the stack. M is bound in every iteration, so
+// UntilM can't loop with a monad that holds the computed value, for example Future: its body is
+// computed only once. For such monads, call the body once and then loop by WhileCondM with the
+// negated condition.
 func UntilM(m Monad, cond func() Monad, unit func(interface{}) Monad) Monad {
+    m2, isOk := unit(struct{} {}).(MonadRec)
+    if isOk {
+        return m2.TailRecM(func(x interface{}) Monad {
+                return m.Bind(func(y interface{}) Monad {
+                        return cond().Bind(func(z interface{}) Monad {
+                                if !BoolOrElse(z, false) {
+                                    return unit(Left(struct{} {}))
+                                } else {
+                                    return unit(Right(struct{} {}))
+                                }
+                        })
+                })
+        }, struct{} {})
+    }
     return m.Bind(func(x interface{}) Monad {
             return cond().Bind(func(y interface{}) Monad {
                     if !BoolOrElse(y, false) {
@@ -73,11 +91,34 @@ func UntilM(m Monad, cond func() Monad, unit func(interface{}) Monad) Monad {
 }
 
 // WhileM is a loop of while type for monads. Unit must be the unit function for specified monad.
+// If the monad is MonadRec, WhileM doesn't grow the stack. Cond is bound in every iteration, so
+// WhileM needs cond that is computed again by Bind, for example ST. WhileM can't loop with a monad
+// that holds the computed value, for example Future: if cond is true, WhileM never ends. Use
+// WhileCondM for such monads.
 func WhileM(cond Monad, body func() Monad, unit func(interface{}) Monad) Monad {
-    return cond.Bind(func(x interface{}) Monad {
+    return WhileCondM(func() Monad { return cond }, body, unit)
+}
+
+// WhileCondM is similar to WhileM but cond is called in every iteration to create the condition.
+func WhileCondM(cond func() Monad, body func() Monad, unit func(interface{}) Monad) Monad {
+    m, isOk := unit(struct{} {}).(MonadRec)
+    if isOk {
+        return m.TailRecM(func(x interface{}) Monad {
+                return cond().Bind(func(y interface{}) Monad {
+                        if BoolOrElse(y, false) {
+                            return body().Bind(func(z interface{}) Monad {
+                                    return unit(Left(struct{} {}))
+                            })
+                        } else {
+                            return unit(Right(struct{} {}))
+                        }
+                })
+        }, struct{} {})
+    }
+    return cond().Bind(func(x interface{}) Monad {
             if BoolOrElse(x, false) {
                 return body().Bind(func(y interface{}) Monad {
-                        return WhileM(cond, body, unit)
+                        return WhileCondM(cond, body, unit)
                 })
             } else {
                 return unit(struct{} {})
@@ -137,20 +178,12 @@ func ListUnit(x interface{}) Monad {
 }
 
 func (m ST) Bind(f func(interface{}) Monad) Monad {
-    return ST(func(s interface{}) (interface{}, interface{}) {
-            s2, x := m(s)
-            m2, isOk := f(x).(ST)
-            if isOk {
-                return m2(s2)
-            } else {
-                return s2, x
-            }
-    })
+    return ST { m: &m, f: f }
 }
 
 // STUnit is an unit function for ST.
 func STUnit(x interface{}) Monad {
-    return ST(func(s interface{}) (interface{}, interface{}) {
+    return NewST(func(s interface{}) (interface{}, interface{}) {
             return s, x
     })
 }
@@ -175,11 +208,12 @@ func InterfaceSliceUnit(x interface{}) Monad {
 
 func (m InterfacePairMap) Bind(f func(interface{}) Monad) Monad {
     ys := make(map[interface{}]interface{}, len(m))
+    ks := newEqualKeys(nil)
     for k, v := range m {
         m2, isOk := f(NewPair(k, v)).(InterfacePairMap)
         if isOk {
             for k2, v2 := range m2 {
-                ys[k2] = v2
+                ys[ks.key(k2)] = v2
             }
         }
     }
@@ -213,3 +247,180 @@ func InterfacePairFunctionUnit(x interface{}) Monad {
             return x
     })
 }
+
+func (m *HashPairMap) Bind(f func(interface{}) Monad) Monad {
+    ys := NewHashPairMap()
+    for _, bucket := range m.buckets {
+        for _, p := range bucket {
+            m2, isOk := f(NewPair(p.First, p.Second)).(*HashPairMap)
+            if isOk {
+                for _, bucket2 := range m2.buckets {
+                    for _, p2 := range bucket2 {
+                        ys.Set(p2.First, p2.Second)
+                    }
+                }
+            }
+        }
+    }
+    return ys
+}
+
+// HashPairMapUnit is an unit function for HashPairMap.
+func HashPairMapUnit(x interface{}) Monad {
+    ys := NewHashPairMap()
+    p, isOk := x.(*Pair)
+    if isOk {
+        ys.Set(p.First, p.Second)
+    }
+    return ys
+}
+
+func (m *Future) Bind(f func(interface{}) Monad) Monad {
+    fut := newFuture()
+    go func() {
+        select {
+        case <-m.done:
+        case <-fut.done:
+            return
+        }
+        if m.result.IsLeft() {
+            fut.complete(m.result)
+            return
+        }
+        fut.completeWith(func() *Either {
+                m2 := f(m.result.GetRight())
+                fut2, isOk := m2.(*Future)
+                if isOk {
+                    select {
+                    case <-fut2.done:
+                        return fut2.result
+                    case <-fut.done:
+                        return nil
+                    }
+                } else {
+                    return Cast[*Future](m2)
+                }
+        })
+    }()
+    return fut
+}
+
+// FutureUnit is an unit function for Future.
+func FutureUnit(x interface{}) Monad {
+    return FromEither(Right(x))
+}
+
+func (m *Lazy) Bind(f func(interface{}) Monad) Monad {
+    return Defer(func() interface{} {
+            m2, isOk := f(m.Force()).(*Lazy)
+            if isOk {
+                return m2.Force()
+            } else {
+                return nil
+            }
+    })
+}
+
+// LazyUnit is an unit function for Lazy. LazyUnit returns the forced Lazy.
+func LazyUnit(x interface{}) Monad {
+    l := Defer(func() interface{} {
+            return x
+    })
+    l.Force()
+    return l
+}
+
+func (m *Stream) Bind(f func(interface{}) Monad) Monad {
+    for s := m; s.isCons; s = s.Tail() {
+        ys, isOk := f(s.head).(*Stream)
+        if isOk && ys.isCons {
+            s2 := s
+            return ys.concat(func() *Stream {
+                    return StreamOrElse(s2.Tail().Bind(f), EmptyStream())
+            })
+        }
+    }
+    return EmptyStream()
+}
+
+// StreamUnit is an unit function for Stream.
+func StreamUnit(x interface{}) Monad {
+    return ConsStream(x, EmptyStream)
+}
+
+func (m *Vector) Bind(f func(interface{}) Monad) Monad {
+    ys := NewVector()
+    m.each(func(x interface{}) bool {
+            m2, isOk := f(x).(*Vector)
+            if isOk {
+                ys = ys.Concat(m2)
+            }
+            return true
+    })
+    return ys
+}
+
+// VectorUnit is an unit function for Vector.
+func VectorUnit(x interface{}) Monad {
+    return NewVector().Append(x)
+}
+
+func (m *HashMap) Bind(f func(interface{}) Monad) Monad {
+    ys := NewHashMap()
+    m.root.each(func(e *hashMapEntry) bool {
+            m2, isOk := f(NewPair(e.key, e.value)).(*HashMap)
+            if isOk {
+                ys = ys.Merge(m2, func(x, y interface{}) interface{} {
+                        return y
+                })
+            }
+            return true
+    })
+    return ys
+}
+
+// HashMapUnit is an unit function for HashMap.
+func HashMapUnit(x interface{}) Monad {
+    p, isOk := x.(*Pair)
+    if isOk {
+        return NewHashMap().Assoc(p.First, p.Second)
+    } else {
+        return NewHashMap()
+    }
+}
+
+func (m *Queue) Bind(f func(interface{}) Monad) Monad {
+    return QueueOrElse(m.FoldLeft(func(x, y interface{}) interface{} {
+            ys, isOk := f(y).(*Queue)
+            if isOk {
+                return ys.FoldLeft(func(x2, y2 interface{}) interface{} {
+                        return QueueOrElse(x2, NewQueue()).PushBack(y2)
+                }, x)
+            } else {
+                return x
+            }
+    }, NewQueue()), NewQueue())
+}
+
+// QueueUnit is an unit function for Queue.
+func QueueUnit(x interface{}) Monad {
+    return NewQueue().PushBack(x)
+}
+
+func (m *Deque) Bind(f func(interface{}) Monad) Monad {
+    return DequeOrElse(m.FoldLeft(func(x, y interface{}) interface{} {
+            ys, isOk := f(y).(*Deque)
+            if isOk {
+                return ys.FoldLeft(func(x2, y2 interface{}) interface{} {
+                        return DequeOrElse(x2, NewDeque()).PushBack(y2)
+                }, x)
+            } else {
+                return x
+            }
+    }, NewDeque()), NewDeque())
+}
+
+// DequeUnit is an unit function for Deque.
+func DequeUnit(x interface{}) Monad {
+    return NewDeque().PushBack(x)
+}
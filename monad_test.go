@@ -118,7 +118,7 @@ func TestBindMethodBindsListForMapMethod(t *testing.T) {
 }
 
 func TestBindMethodBindsST(t *testing.T) {
-    m := ST(func(s interface{}) (interface{}, interface{}) {
+    m := NewST(func(s interface{}) (interface{}, interface{}) {
             return s, 2
     }).Bind(func(x interface{}) Monad {
             return STUnit(IntOrElse(x, 0) + 1)
@@ -138,10 +138,10 @@ func TestBindMethodBindsST(t *testing.T) {
 }
 
 func TestBindMethodBindsSTForMapMethod(t *testing.T) {
-    m := ST(func(s interface{}) (interface{}, interface{}) {
+    m := NewST(func(s interface{}) (interface{}, interface{}) {
             return s, 2
     }).Bind(func(x interface{}) Monad {
-            m2 := ST(func(s interface{}) (interface{}, interface{}) {
+            m2 := NewST(func(s interface{}) (interface{}, interface{}) {
                     return IntOrElse(s, 0) + 1, 3
             }).Map(func(y interface {}) interface{} {
                 return IntOrElse(x, 0) + IntOrElse(y, 0) + 1
@@ -212,6 +212,16 @@ func TestBindMethodBindsInterfacePairMap(t *testing.T) {
     }
 }
 
+func TestBindMethodMergesEqualPairKeysOfInterfacePairMap(t *testing.T) {
+    xs := InterfacePairMapOrElse(InterfacePairMap(map[interface{}]interface{} { "a": 1, "b": 1 }).Bind(func(x interface{}) Monad {
+            p := PairOrElse(x, NewPair("", 0))
+            return InterfacePairMap(map[interface{}]interface{} { NewPair(p.Second, 0): p.Second })
+    }), nil)
+    if len(xs) != 1 {
+        t.Errorf("length of Bind method result is %v; want %v", len(xs), 1)
+    }
+}
+
 func TestBindMethodBindsInterfacePairMapForMapMethod(t *testing.T) {
     m := InterfacePairMap(map[interface{}]interface{} { "a": 1, "b": 2 }).Bind(func(x interface{}) Monad {
             m2 := InterfacePairMap(map[interface{}]interface{} { "c": 3, "d": 4 }).Map(func(y interface{}) interface{} {
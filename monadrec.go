@@ -0,0 +1,206 @@
+/*
+ * Copyright (c) 2020 Łukasz Szpakowski
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in
+ * all copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+ * THE SOFTWARE.
+ */
+
+package gofun
+
+// MonadRec is the interface for monads that have stack-safe tail recursion.
+type MonadRec interface {
+    Monad
+    // TailRecM calls f for x and then calls f for the left value from Monad that is returned by
+    // the previous call of f while this Monad contains Either with the left value. If Monad
+    // contains Either with the right value, the right value is the result. TailRecM uses a loop,
+    // so it doesn't grow the stack.
+    TailRecM(f func(interface{}) Monad, x interface{}) Monad
+}
+
+// MonadRecOrElse returns x if x is MonadRec, otherwise y.
+func MonadRecOrElse(x interface{}, y MonadRec) MonadRec {
+    z, isOk := x.(MonadRec)
+    if isOk {
+        return z
+    } else {
+        return y
+    }
+}
+
+// TailRecM calls the TailRecM method if the monad is MonadRec; otherwise TailRecM uses recursive
+// binding. Unit must be the unit function for specified monad.
+func TailRecM(f func(interface{}) Monad, x interface{}, unit func(interface{}) Monad) Monad {
+    m, isOk := unit(x).(MonadRec)
+    if isOk {
+        return m.TailRecM(f, x)
+    } else {
+        return f(x).Bind(func(y interface{}) Monad {
+                e, isOk2 := y.(*Either)
+                if isOk2 {
+                    if e.IsLeft() {
+                        return TailRecM(f, e.GetLeft(), unit)
+                    } else {
+                        return unit(e.GetRight())
+                    }
+                } else {
+                    return unit(y)
+                }
+        })
+    }
+}
+
+func (m *Option) TailRecM(f func(interface{}) Monad, x interface{}) Monad {
+    for {
+        o, isOk := f(x).(*Option)
+        if !isOk || o.IsNone() {
+            return None()
+        }
+        e, isOk2 := o.Get().(*Either)
+        if !isOk2 {
+            return o
+        }
+        if e.IsRight() {
+            return Some(e.GetRight())
+        }
+        x = e.GetLeft()
+    }
+}
+
+func (m *Either) TailRecM(f func(interface{}) Monad, x interface{}) Monad {
+    for {
+        m2 := f(x)
+        e, isOk := m2.(*Either)
+        if !isOk {
+            return m2
+        }
+        if e.IsLeft() {
+            return e
+        }
+        e2, isOk2 := e.GetRight().(*Either)
+        if !isOk2 {
+            return e
+        }
+        if e2.IsRight() {
+            return Right(e2.GetRight())
+        }
+        x = e2.GetLeft()
+    }
+}
+
+func (m *List) TailRecM(f func(interface{}) Monad, x interface{}) Monad {
+    var ys *List = Nil()
+    var prev *List = nil
+    stack := []*List { ListOrElse(f(x), Nil()) }
+    for len(stack) > 0 {
+        l := stack[len(stack) - 1]
+        if l.IsNil() {
+            stack = stack[:len(stack) - 1]
+            continue
+        }
+        stack[len(stack) - 1] = l.Tail()
+        e, isOk := l.Head().(*Either)
+        if isOk && e.IsLeft() {
+            stack = append(stack, ListOrElse(f(e.GetLeft()), Nil()))
+        } else {
+            var y interface{}
+            if isOk {
+                y = e.GetRight()
+            } else {
+                y = l.Head()
+            }
+            l2 := Cons(y, Nil())
+            if prev != nil {
+                prev.SetTail(l2)
+            } else {
+                ys = l2
+            }
+            prev = l2
+        }
+    }
+    return ys
+}
+
+func (m ST) TailRecM(f func(interface{}) Monad, x interface{}) Monad {
+    m2, isOk := f(x).(ST)
+    if isOk {
+        return m2.Bind(func(y interface{}) Monad {
+                e, isOk2 := y.(*Either)
+                if isOk2 && e.IsLeft() {
+                    return m.TailRecM(f, e.GetLeft())
+                } else if isOk2 {
+                    return STUnit(e.GetRight())
+                } else {
+                    return STUnit(y)
+                }
+        })
+    } else {
+        return STUnit(x)
+    }
+}
+
+func (m InterfaceSlice) TailRecM(f func(interface{}) Monad, x interface{}) Monad {
+    ys := make([]interface{}, 0)
+    stack := []InterfaceSlice { InterfaceSliceOrElse(f(x), InterfaceSlice([]interface{} {})) }
+    for len(stack) > 0 {
+        zs := stack[len(stack) - 1]
+        if len(zs) == 0 {
+            stack = stack[:len(stack) - 1]
+            continue
+        }
+        stack[len(stack) - 1] = zs[1:]
+        e, isOk := zs[0].(*Either)
+        if isOk && e.IsLeft() {
+            stack = append(stack, InterfaceSliceOrElse(f(e.GetLeft()), InterfaceSlice([]interface{} {})))
+        } else if isOk {
+            ys = append(ys, e.GetRight())
+        } else {
+            ys = append(ys, zs[0])
+        }
+    }
+    return InterfaceSlice(ys)
+}
+
+func (m *Future) TailRecM(f func(interface{}) Monad, x interface{}) Monad {
+    fut := newFuture()
+    go fut.completeWith(func() *Either {
+            for {
+                m2 := f(x)
+                fut2, isOk := m2.(*Future)
+                if !isOk {
+                    return Cast[*Future](m2)
+                }
+                select {
+                case <-fut2.done:
+                case <-fut.done:
+                    return nil
+                }
+                if fut2.result.IsLeft() {
+                    return fut2.result
+                }
+                e, isOk2 := fut2.result.GetRight().(*Either)
+                if !isOk2 {
+                    return fut2.result
+                }
+                if e.IsRight() {
+                    return Right(e.GetRight())
+                }
+                x = e.GetLeft()
+            }
+    })
+    return fut
+}
@@ -0,0 +1,164 @@
+/*
+ * Copyright (c) 2020 Łukasz Szpakowski
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in
+ * all copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+ * THE SOFTWARE.
+ */
+
+package gofun_test
+import (
+    "reflect"
+    "runtime/debug"
+    "testing"
+    . "gofun"
+)
+
+func TestTailRecMMethodLoopsForOption(t *testing.T) {
+    m := None().TailRecM(func(x interface{}) Monad {
+            if IntOrElse(x, 0) < 1000000 {
+                return Some(Left(IntOrElse(x, 0) + 1))
+            } else {
+                return Some(Right(x))
+            }
+    }, 0)
+    if !reflect.DeepEqual(m, Some(1000000)) {
+        t.Errorf("TailRecM method result is %v; want %v", m, Some(1000000))
+    }
+}
+
+func TestTailRecMMethodReturnsLeftForEither(t *testing.T) {
+    m := Left(nil).TailRecM(func(x interface{}) Monad {
+            if IntOrElse(x, 0) < 3 {
+                return Right(Left(IntOrElse(x, 0) + 1))
+            } else {
+                return Left("error")
+            }
+    }, 0)
+    if !reflect.DeepEqual(m, Left("error")) {
+        t.Errorf("TailRecM method result is %v; want %v", m, Left("error"))
+    }
+}
+
+func TestTailRecMMethodLoopsForList(t *testing.T) {
+    m := Nil().TailRecM(func(x interface{}) Monad {
+            if IntOrElse(x, 0) < 2 {
+                return Cons(Left(IntOrElse(x, 0) + 1), Cons(Right(x), Nil()))
+            } else {
+                return Cons(Right(x), Nil())
+            }
+    }, 0)
+    if !reflect.DeepEqual(m, Cons(2, Cons(1, Cons(0, Nil())))) {
+        t.Errorf("TailRecM method result is %v; want %v", m, Cons(2, Cons(1, Cons(0, Nil()))))
+    }
+}
+
+func TestTailRecMMethodLoopsForInterfaceSlice(t *testing.T) {
+    m := InterfaceSlice([]interface{} {}).TailRecM(func(x interface{}) Monad {
+            if IntOrElse(x, 0) < 2 {
+                return InterfaceSlice([]interface{} { Right(x), Left(IntOrElse(x, 0) + 1) })
+            } else {
+                return InterfaceSlice([]interface{} { Right(x) })
+            }
+    }, 0)
+    if !reflect.DeepEqual(m, InterfaceSlice([]interface{} { 0, 1, 2 })) {
+        t.Errorf("TailRecM method result is %v; want %v", m, InterfaceSlice([]interface{} { 0, 1, 2 }))
+    }
+}
+
+func TestTailRecMFunctionLoopsForInterfacePairFunction(t *testing.T) {
+    m := TailRecM(func(x interface{}) Monad {
+            if IntOrElse(x, 0) < 3 {
+                return InterfacePairFunctionUnit(Left(IntOrElse(x, 0) + 1))
+            } else {
+                return InterfacePairFunctionUnit(Right(x))
+            }
+    }, 0, InterfacePairFunctionUnit)
+    l, isOk := m.(InterfacePairFunction)
+    if !isOk {
+        t.Errorf("TailRecM function result type isn't InterfacePairFunction")
+    } else {
+        y := l(10)
+        if !reflect.DeepEqual(y, 3) {
+            t.Errorf("function result of TailRecM function result is %v; want %v", y, 3)
+        }
+    }
+}
+
+func TestWhileMFunctionDoesNotGrowStackForST(t *testing.T) {
+    old := debug.SetMaxStack(1 << 20)
+    defer debug.SetMaxStack(old)
+    m := WhileM(GetST().Bind(func(s interface{}) Monad {
+            return STUnit(IntOrElse(s, 0) < 300000)
+    }), func() Monad {
+            return GetST().Bind(func(s interface{}) Monad {
+                    return SetST(IntOrElse(s, 0) + 1)
+            })
+    }, STUnit)
+    s, _ := RunST(STOrElse(m, GetST()), 0)
+    if !reflect.DeepEqual(s, 300000) {
+        t.Errorf("RunST function first result from WhileM function result is %v; want %v", s, 300000)
+    }
+}
+
+func TestUntilMFunctionDoesNotGrowStackForST(t *testing.T) {
+    old := debug.SetMaxStack(1 << 20)
+    defer debug.SetMaxStack(old)
+    m := UntilM(GetST().Bind(func(s interface{}) Monad {
+            return SetST(IntOrElse(s, 0) + 1)
+    }), func() Monad {
+            return GetST().Bind(func(s interface{}) Monad {
+                    return STUnit(IntOrElse(s, 0) >= 300000)
+            })
+    }, STUnit)
+    s, _ := RunST(STOrElse(m, GetST()), 0)
+    if !reflect.DeepEqual(s, 300000) {
+        t.Errorf("RunST function first result from UntilM function result is %v; want %v", s, 300000)
+    }
+}
+
+func TestFoldLeftMFunctionDoesNotGrowStackForST(t *testing.T) {
+    old := debug.SetMaxStack(1 << 20)
+    defer debug.SetMaxStack(old)
+    xs := make([]interface{}, 300000)
+    for i := range xs {
+        xs[i] = 1
+    }
+    m := FoldLeftM(func(x, y interface{}) Monad {
+            return GetST().Bind(func(s interface{}) Monad {
+                    return SetST(IntOrElse(s, 0) + IntOrElse(y, 0)).Map(func(z interface{}) interface{} {
+                            return IntOrElse(x, 0) + 1
+                    }).(Monad)
+            })
+    }, 0, InterfaceSlice(xs), STUnit)
+    s, x := RunST(STOrElse(m, GetST()), 0)
+    if !reflect.DeepEqual(s, 300000) {
+        t.Errorf("RunST function first result from FoldLeftM function result is %v; want %v", s, 300000)
+    }
+    if !reflect.DeepEqual(x, 300000) {
+        t.Errorf("RunST function second result from FoldLeftM function result is %v; want %v", x, 300000)
+    }
+}
+
+func TestFoldRightMFunctionFoldsForOption(t *testing.T) {
+    m := FoldRightM(func(y, x interface{}) Monad {
+            return Some(StringOrElse(x, "") + StringOrElse(y, ""))
+    }, "z", InterfaceSlice([]interface{} { "a", "b", "c" }), OptionUnit)
+    if !reflect.DeepEqual(m, Some("zcba")) {
+        t.Errorf("FoldRightM function result is %v; want %v", m, Some("zcba"))
+    }
+}
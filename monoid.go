@@ -0,0 +1,287 @@
+/*
+ * Copyright (c) 2020 Łukasz Szpakowski
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in
+ * all copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+ * THE SOFTWARE.
+ */
+
+package gofun
+
+// Semigroup is the interface for semigroups. Append must be associative.
+type Semigroup interface {
+    // Append combines two values.
+    Append(x, y interface{}) interface{}
+}
+
+// Monoid is the interface for monoids. Empty must be the identity of Append.
+type Monoid interface {
+    Semigroup
+    // Empty returns the identity.
+    Empty() interface{}
+}
+
+// SemigroupOrElse returns x if x is Semigroup, otherwise y.
+func SemigroupOrElse(x interface{}, y Semigroup) Semigroup {
+    z, isOk := x.(Semigroup)
+    if isOk {
+        return z
+    } else {
+        return y
+    }
+}
+
+// MonoidOrElse returns x if x is Monoid, otherwise y.
+func MonoidOrElse(x interface{}, y Monoid) Monoid {
+    z, isOk := x.(Monoid)
+    if isOk {
+        return z
+    } else {
+        return y
+    }
+}
+
+// Number is the constraint for the builtin numeric types.
+type Number interface {
+    ~int | ~int8 | ~int16 | ~int32 | ~int64 |
+    ~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64 | ~uintptr |
+    ~float32 | ~float64 | ~complex64 | ~complex128
+}
+
+type semigroup struct {
+    append func(interface{}, interface{}) interface{}
+}
+
+func (s *semigroup) Append(x, y interface{}) interface{} {
+    return s.append(x, y)
+}
+
+type monoid struct {
+    semigroup
+    empty interface{}
+    concat func([]interface{}) interface{}
+}
+
+func (m *monoid) Empty() interface{} {
+    return m.empty
+}
+
+// NewSemigroup creates Semigroup from an associative function.
+func NewSemigroup(f func(interface{}, interface{}) interface{}) Semigroup {
+    return &semigroup { append: f }
+}
+
+// NewMonoid creates Monoid from the identity and an associative function.
+func NewMonoid(empty interface{}, f func(interface{}, interface{}) interface{}) Monoid {
+    return &monoid { semigroup: semigroup { append: f }, empty: empty }
+}
+
+// newConcatMonoid creates Monoid that also has concat, which combines many values at once without
+// copying the partial results.
+func newConcatMonoid(empty interface{}, f func(interface{}, interface{}) interface{}, concat func([]interface{}) interface{}) Monoid {
+    return &monoid { semigroup: semigroup { append: f }, empty: empty, concat: concat }
+}
+
+// mconcat combines xs by m. If m hasn't concat, mconcat folds xs from right side, so Append
+// that copies its first argument copies every value only once.
+func mconcat(m Monoid, xs []interface{}) interface{} {
+    m2, isOk := m.(*monoid)
+    if isOk && m2.concat != nil {
+        return m2.concat(xs)
+    }
+    return InterfaceSlice(xs).FoldRight(m.Append, m.Empty())
+}
+
+// SumMonoid returns Monoid of addition for T.
+func SumMonoid[T Number]() Monoid {
+    var zero T
+    return NewMonoid(zero, func(x, y interface{}) interface{} {
+            return OrElse(x, zero) + OrElse(y, zero)
+    })
+}
+
+// ProductMonoid returns Monoid of multiplication for T.
+func ProductMonoid[T Number]() Monoid {
+    var zero T
+    return NewMonoid(T(1), func(x, y interface{}) interface{} {
+            return OrElse(x, zero) * OrElse(y, zero)
+    })
+}
+
+// MinMonoid returns Monoid of optional values that chooses the least value. The values are
+// compared by the Compare function.
+func MinMonoid() Monoid {
+    return MinMonoidBy(Compare)
+}
+
+// MinMonoidBy is similar to MinMonoid but compares values by cmp.
+func MinMonoidBy(cmp func(interface{}, interface{}) Ordering) Monoid {
+    return OptionMonoid(NewSemigroup(func(x, y interface{}) interface{} {
+            if cmp(y, x) == LT {
+                return y
+            } else {
+                return x
+            }
+    }))
+}
+
+// MaxMonoid returns Monoid of optional values that chooses the greatest value. The values are
+// compared by the Compare function.
+func MaxMonoid() Monoid {
+    return MaxMonoidBy(Compare)
+}
+
+// MaxMonoidBy is similar to MaxMonoid but compares values by cmp.
+func MaxMonoidBy(cmp func(interface{}, interface{}) Ordering) Monoid {
+    return OptionMonoid(NewSemigroup(func(x, y interface{}) interface{} {
+            if cmp(y, x) == GT {
+                return y
+            } else {
+                return x
+            }
+    }))
+}
+
+// FirstMonoid returns Monoid of optional values that chooses the first value.
+func FirstMonoid() Monoid {
+    return NewMonoid(None(), func(x, y interface{}) interface{} {
+            return OptionOrElse(x, None()).OrElse(func() *Option {
+                    return OptionOrElse(y, None())
+            })
+    })
+}
+
+// LastMonoid returns Monoid of optional values that chooses the last value.
+func LastMonoid() Monoid {
+    return NewMonoid(None(), func(x, y interface{}) interface{} {
+            return OptionOrElse(y, None()).OrElse(func() *Option {
+                    return OptionOrElse(x, None())
+            })
+    })
+}
+
+// AllMonoid returns Monoid of conjunction.
+func AllMonoid() Monoid {
+    return NewMonoid(true, func(x, y interface{}) interface{} {
+            return BoolOrElse(x, false) && BoolOrElse(y, false)
+    })
+}
+
+// AnyMonoid returns Monoid of disjunction.
+func AnyMonoid() Monoid {
+    return NewMonoid(false, func(x, y interface{}) interface{} {
+            return BoolOrElse(x, false) || BoolOrElse(y, false)
+    })
+}
+
+// StringMonoid returns Monoid of string concatenation.
+func StringMonoid() Monoid {
+    return NewMonoid("", func(x, y interface{}) interface{} {
+            return StringOrElse(x, "") + StringOrElse(y, "")
+    })
+}
+
+// InterfaceSliceMonoid returns Monoid of slice concatenation. Append creates a new slice, but
+// FoldMap and MConcat create one slice for all the slices.
+func InterfaceSliceMonoid() Monoid {
+    concat := func(xs []interface{}) interface{} {
+        n := 0
+        for _, x := range xs {
+            n += len(InterfaceSliceOrElse(x, InterfaceSlice([]interface{} {})))
+        }
+        zs := make([]interface{}, 0, n)
+        for _, x := range xs {
+            zs = append(zs, InterfaceSliceOrElse(x, InterfaceSlice([]interface{} {}))...)
+        }
+        return InterfaceSlice(zs)
+    }
+    return newConcatMonoid(InterfaceSlice([]interface{} {}), func(x, y interface{}) interface{} {
+            return concat([]interface{} { x, y })
+    }, concat)
+}
+
+// ListMonoid returns Monoid of list concatenation. Append copies the first list, but FoldMap and
+// MConcat copy every list only once.
+func ListMonoid() Monoid {
+    return newConcatMonoid(Nil(), func(x, y interface{}) interface{} {
+            return ListOrElse(x, Nil()).Concat(ListOrElse(y, Nil()))
+    }, func(xs []interface{}) interface{} {
+            l := Nil()
+            for i := len(xs) - 1; i >= 0; i-- {
+                l = ListOrElse(xs[i], Nil()).Concat(l)
+            }
+            return l
+    })
+}
+
+// OptionMonoid returns Monoid of optional values that combines the values by s. None is the
+// identity.
+func OptionMonoid(s Semigroup) Monoid {
+    return NewMonoid(None(), func(x, y interface{}) interface{} {
+            o := OptionOrElse(x, None())
+            o2 := OptionOrElse(y, None())
+            if o.IsSome() && o2.IsSome() {
+                return Some(s.Append(o.Get(), o2.Get()))
+            } else if o.IsSome() {
+                return o
+            } else {
+                return o2
+            }
+    })
+}
+
+// InterfacePairMapMonoid returns Monoid of map union. The keys are compared by the Equal function.
+// If both maps contain the key, the value from the first map is chosen. Append creates a new map,
+// but FoldMap and MConcat create one map for all the maps.
+func InterfacePairMapMonoid() Monoid {
+    concat := func(xs []interface{}) interface{} {
+        zs := make(map[interface{}]interface{})
+        ks := newEqualKeys(nil)
+        for i := len(xs) - 1; i >= 0; i-- {
+            for k, v := range InterfacePairMapOrElse(xs[i], InterfacePairMap(map[interface{}]interface{} {})) {
+                zs[ks.key(k)] = v
+            }
+        }
+        return InterfacePairMap(zs)
+    }
+    return newConcatMonoid(InterfacePairMap(map[interface{}]interface{} {}), func(x, y interface{}) interface{} {
+            return concat([]interface{} { x, y })
+    }, concat)
+}
+
+// OrderingMonoid returns Monoid of Ordering.
+func OrderingMonoid() Monoid {
+    return NewMonoid(EQ, func(x, y interface{}) interface{} {
+            return OrderingOrElse(x, EQ).Append(OrderingOrElse(y, EQ))
+    })
+}
+
+// FoldMap maps the elements by f and combines the results by m. F is called for the elements from
+// left side.
+func FoldMap(f func(interface{}) interface{}, m Monoid, xs Foldable) interface{} {
+    ys := make([]interface{}, 0)
+    xs.FoldLeft(func(x, y interface{}) interface{} {
+            ys = append(ys, f(y))
+            return nil
+    }, nil)
+    return mconcat(m, ys)
+}
+
+// MConcat combines the elements by m.
+func MConcat(m Monoid, xs Foldable) interface{} {
+    return mconcat(m, ToSlice(xs))
+}
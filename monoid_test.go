@@ -0,0 +1,205 @@
+/*
+ * Copyright (c) 2020 Łukasz Szpakowski
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in
+ * all copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+ * THE SOFTWARE.
+ */
+
+package gofun_test
+import (
+    "reflect"
+    "testing"
+    . "gofun"
+)
+
+func TestFoldMapFunctionFoldsForSumMonoid(t *testing.T) {
+    x := FoldMap(func(x interface{}) interface{} {
+            return len(StringOrElse(x, ""))
+    }, SumMonoid[int](), InterfaceSlice([]interface{} { "a", "bc", "def" }))
+    if !reflect.DeepEqual(x, 6) {
+        t.Errorf("FoldMap function result is %v; want %v", x, 6)
+    }
+}
+
+func TestMConcatFunctionConcatenatesForProductMonoid(t *testing.T) {
+    x := MConcat(ProductMonoid[float64](), Cons(1.5, Cons(2.0, Nil())))
+    if !reflect.DeepEqual(x, 3.0) {
+        t.Errorf("MConcat function result is %v; want %v", x, 3.0)
+    }
+}
+
+func TestMConcatFunctionConcatenatesEmptyListForSumMonoid(t *testing.T) {
+    x := MConcat(SumMonoid[int64](), Nil())
+    if !reflect.DeepEqual(x, int64(0)) {
+        t.Errorf("MConcat function result is %v; want %v", x, int64(0))
+    }
+}
+
+func TestFoldMapFunctionFoldsForMinMonoid(t *testing.T) {
+    x := FoldMap(func(x interface{}) interface{} {
+            return Some(x)
+    }, MinMonoid(), InterfaceSlice([]interface{} { 3, 1, 2 }))
+    if !reflect.DeepEqual(x, Some(1)) {
+        t.Errorf("FoldMap function result is %v; want %v", x, Some(1))
+    }
+}
+
+func TestFoldMapFunctionFoldsForMaxMonoid(t *testing.T) {
+    x := FoldMap(func(x interface{}) interface{} {
+            return Some(x)
+    }, MaxMonoid(), InterfaceSlice([]interface{} { 3, 1, 2 }))
+    if !reflect.DeepEqual(x, Some(3)) {
+        t.Errorf("FoldMap function result is %v; want %v", x, Some(3))
+    }
+}
+
+func TestMConcatFunctionConcatenatesForFirstMonoid(t *testing.T) {
+    x := MConcat(FirstMonoid(), InterfaceSlice([]interface{} { None(), Some(2), Some(3) }))
+    if !reflect.DeepEqual(x, Some(2)) {
+        t.Errorf("MConcat function result is %v; want %v", x, Some(2))
+    }
+}
+
+func TestMConcatFunctionConcatenatesForLastMonoid(t *testing.T) {
+    x := MConcat(LastMonoid(), InterfaceSlice([]interface{} { Some(2), Some(3), None() }))
+    if !reflect.DeepEqual(x, Some(3)) {
+        t.Errorf("MConcat function result is %v; want %v", x, Some(3))
+    }
+}
+
+func TestFoldMapFunctionFoldsForAllMonoidAndAnyMonoid(t *testing.T) {
+    f := func(x interface{}) interface{} { return IntOrElse(x, 0) > 1 }
+    xs := InterfaceSlice([]interface{} { 1, 2, 3 })
+    x := FoldMap(f, AllMonoid(), xs)
+    if !reflect.DeepEqual(x, false) {
+        t.Errorf("FoldMap function result is %v; want %v", x, false)
+    }
+    y := FoldMap(f, AnyMonoid(), xs)
+    if !reflect.DeepEqual(y, true) {
+        t.Errorf("FoldMap function result is %v; want %v", y, true)
+    }
+}
+
+func TestMConcatFunctionConcatenatesForStringMonoid(t *testing.T) {
+    x := MConcat(StringMonoid(), Cons("a", Cons("b", Cons("c", Nil()))))
+    if !reflect.DeepEqual(x, "abc") {
+        t.Errorf("MConcat function result is %v; want %v", x, "abc")
+    }
+}
+
+func TestMConcatFunctionConcatenatesForInterfaceSliceMonoid(t *testing.T) {
+    x := MConcat(InterfaceSliceMonoid(), Cons(InterfaceSlice([]interface{} { 1 }), Cons(InterfaceSlice([]interface{} { 2, 3 }), Nil())))
+    if !reflect.DeepEqual(x, InterfaceSlice([]interface{} { 1, 2, 3 })) {
+        t.Errorf("MConcat function result is %v; want %v", x, InterfaceSlice([]interface{} { 1, 2, 3 }))
+    }
+}
+
+func TestMConcatFunctionConcatenatesForListMonoid(t *testing.T) {
+    x := MConcat(ListMonoid(), InterfaceSlice([]interface{} { Cons(1, Nil()), Nil(), Cons(2, Cons(3, Nil())) }))
+    if !reflect.DeepEqual(x, Cons(1, Cons(2, Cons(3, Nil())))) {
+        t.Errorf("MConcat function result is %v; want %v", x, Cons(1, Cons(2, Cons(3, Nil()))))
+    }
+}
+
+func TestMConcatFunctionConcatenatesForOptionMonoid(t *testing.T) {
+    x := MConcat(OptionMonoid(StringMonoid()), InterfaceSlice([]interface{} { Some("a"), None(), Some("b") }))
+    if !reflect.DeepEqual(x, Some("ab")) {
+        t.Errorf("MConcat function result is %v; want %v", x, Some("ab"))
+    }
+}
+
+func TestMConcatFunctionConcatenatesForInterfacePairMapMonoid(t *testing.T) {
+    x := MConcat(InterfacePairMapMonoid(), InterfaceSlice([]interface{} {
+            InterfacePairMap(map[interface{}]interface{} { "a": 1, "b": 2 }),
+            InterfacePairMap(map[interface{}]interface{} { "b": 3, "c": 4 }),
+    }))
+    if !reflect.DeepEqual(x, InterfacePairMap(map[interface{}]interface{} { "a": 1, "b": 2, "c": 4 })) {
+        t.Errorf("MConcat function result is %v; want %v", x, InterfacePairMap(map[interface{}]interface{} { "a": 1, "b": 2, "c": 4 }))
+    }
+}
+
+func TestMConcatFunctionConcatenatesForOrderingMonoid(t *testing.T) {
+    x := MConcat(OrderingMonoid(), InterfaceSlice([]interface{} { EQ, GT, LT }))
+    if !reflect.DeepEqual(x, GT) {
+        t.Errorf("MConcat function result is %v; want %v", x, GT)
+    }
+}
+
+func TestInterfacePairMapMonoidMergesEqualPairKeys(t *testing.T) {
+    m := InterfacePairMapMonoid()
+    xs := InterfacePairMapOrElse(m.Append(InterfacePairMap(map[interface{}]interface{} { NewPair(1, 2): "a" }), InterfacePairMap(map[interface{}]interface{} { NewPair(1, 2): "b" })), nil)
+    if len(xs) != 1 {
+        t.Errorf("length of InterfacePairMapMonoid Append method result is %v; want %v", len(xs), 1)
+    }
+    for _, v := range xs {
+        if v != "a" {
+            t.Errorf("value of InterfacePairMapMonoid Append method result is %v; want %v", v, "a")
+        }
+    }
+}
+
+func TestFoldMapFunctionFoldsManyListsForListMonoid(t *testing.T) {
+    xs := make([]interface{}, 0, 100000)
+    for i := 0; i < 100000; i++ {
+        xs = append(xs, i)
+    }
+    l := ListOrElse(FoldMap(func(x interface{}) interface{} {
+            return Cons(x, Nil())
+    }, ListMonoid(), InterfaceSlice(xs)), Nil())
+    if !reflect.DeepEqual(ToSlice(l), InterfaceSlice(xs)) {
+        t.Errorf("FoldMap function result has %v elements; want %v", Length(l), len(xs))
+    }
+}
+
+func TestFoldMapFunctionFoldsManySlicesForInterfaceSliceMonoid(t *testing.T) {
+    xs := make([]interface{}, 0, 100000)
+    for i := 0; i < 100000; i++ {
+        xs = append(xs, i)
+    }
+    ys := FoldMap(func(x interface{}) interface{} {
+            return InterfaceSlice([]interface{} { x })
+    }, InterfaceSliceMonoid(), InterfaceSlice(xs))
+    if !reflect.DeepEqual(ys, InterfaceSlice(xs)) {
+        t.Errorf("FoldMap function result has %v elements; want %v", Length(FoldableOrElse(ys, nil)), len(xs))
+    }
+}
+
+func TestMConcatFunctionChoosesFirstValueForInterfacePairMapMonoid(t *testing.T) {
+    xs := InterfacePairMapOrElse(MConcat(InterfacePairMapMonoid(), InterfaceSlice([]interface{} {
+            InterfacePairMap(map[interface{}]interface{} { NewPair(1, 2): "a" }),
+            InterfacePairMap(map[interface{}]interface{} { NewPair(1, 2): "b", "c": 3 }),
+            InterfacePairMap(map[interface{}]interface{} { NewPair(1, 2): "d", "c": 4 }),
+    })), nil)
+    if !Equal(xs, InterfacePairMap(map[interface{}]interface{} { NewPair(1, 2): "a", "c": 3 })) {
+        t.Errorf("MConcat function result is %v; want %v", xs, InterfacePairMap(map[interface{}]interface{} { NewPair(1, 2): "a", "c": 3 }))
+    }
+}
+
+func TestFoldMapFunctionCallsFunctionFromLeftSide(t *testing.T) {
+    ys := make([]interface{}, 0)
+    s := FoldMap(func(x interface{}) interface{} {
+            ys = append(ys, x)
+            return StringOrElse(x, "")
+    }, StringMonoid(), InterfaceSlice([]interface{} { "a", "b", "c" }))
+    if s != "abc" {
+        t.Errorf("FoldMap function result is %v; want %v", s, "abc")
+    }
+    if !reflect.DeepEqual(ys, []interface{} { "a", "b", "c" }) {
+        t.Errorf("FoldMap function calls are %v; want %v", ys, []interface{} { "a", "b", "c" })
+    }
+}
@@ -0,0 +1,414 @@
+/*
+ * Copyright (c) 2020 Łukasz Szpakowski
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in
+ * all copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+ * THE SOFTWARE.
+ */
+
+package gofun
+import (
+    "reflect"
+    "sort"
+)
+
+// Ordering represents results of comparison. Ordering is a monoid where EQ is the identity and
+// Append returns the first result that isn't EQ.
+type Ordering int
+
+const (
+    // LT means that the first value is less than the second value.
+    LT Ordering = -1
+    // EQ means that the first value is equal to the second value.
+    EQ Ordering = 0
+    // GT means that the first value is greater than the second value.
+    GT Ordering = 1
+)
+
+// OrderingOrElse returns x if x is Ordering, otherwise y.
+func OrderingOrElse(x interface{}, y Ordering) Ordering {
+    return OrElse(x, y)
+}
+
+// NewOrdering creates Ordering from the sign of x.
+func NewOrdering(x int) Ordering {
+    if x < 0 {
+        return LT
+    } else if x > 0 {
+        return GT
+    } else {
+        return EQ
+    }
+}
+
+// Append returns o if o isn't EQ, otherwise o2.
+func (o Ordering) Append(o2 Ordering) Ordering {
+    if o != EQ {
+        return o
+    } else {
+        return o2
+    }
+}
+
+// Reverse reverses the result of comparison.
+func (o Ordering) Reverse() Ordering {
+    return -o
+}
+
+func (o Ordering) String() string {
+    switch o {
+    case LT:
+        return "LT"
+    case GT:
+        return "GT"
+    default:
+        return "EQ"
+    }
+}
+
+// Ord is the interface for ordered values.
+type Ord interface {
+    // Compare returns a negative number if the value is less than other, zero if the value is
+    // equal to other, and a positive number if the value is greater than other.
+    Compare(other interface{}) int
+}
+
+// OrdOrElse returns x if x is Ord, otherwise y.
+func OrdOrElse(x interface{}, y Ord) Ord {
+    z, isOk := x.(Ord)
+    if isOk {
+        return z
+    } else {
+        return y
+    }
+}
+
+// Compare compares x and y. If x is Ord, Compare uses the Compare method. Values of the builtin
+// boolean, numeric and string types are compared by their values. Values that have different types
+// are compared by the names of their types. Other values are equal.
+func Compare(x, y interface{}) Ordering {
+    x2, isOk := x.(Ord)
+    if isOk {
+        return NewOrdering(x2.Compare(y))
+    }
+    y2, isOk2 := y.(Ord)
+    if isOk2 {
+        return NewOrdering(y2.Compare(x)).Reverse()
+    }
+    if x == nil || y == nil {
+        if x == nil && y == nil {
+            return EQ
+        } else if x == nil {
+            return LT
+        } else {
+            return GT
+        }
+    }
+    v, w := reflect.ValueOf(x), reflect.ValueOf(y)
+    if v.Type() != w.Type() {
+        return compareStrings(v.Type().String(), w.Type().String())
+    }
+    switch v.Kind() {
+    case reflect.Bool:
+        if v.Bool() == w.Bool() {
+            return EQ
+        } else if w.Bool() {
+            return LT
+        } else {
+            return GT
+        }
+    case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+        if v.Int() < w.Int() {
+            return LT
+        } else if v.Int() > w.Int() {
+            return GT
+        } else {
+            return EQ
+        }
+    case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+        if v.Uint() < w.Uint() {
+            return LT
+        } else if v.Uint() > w.Uint() {
+            return GT
+        } else {
+            return EQ
+        }
+    case reflect.Float32, reflect.Float64:
+        if v.Float() < w.Float() {
+            return LT
+        } else if v.Float() > w.Float() {
+            return GT
+        } else {
+            return EQ
+        }
+    case reflect.String:
+        return compareStrings(v.String(), w.String())
+    default:
+        return EQ
+    }
+}
+
+func compareStrings(s, t string) Ordering {
+    if s < t {
+        return LT
+    } else if s > t {
+        return GT
+    } else {
+        return EQ
+    }
+}
+
+// Comparing returns a comparator that compares the keys of values. The keys are returned by key
+// and are compared by the Compare function.
+func Comparing(key func(interface{}) interface{}) func(interface{}, interface{}) Ordering {
+    return func(x, y interface{}) Ordering {
+        return Compare(key(x), key(y))
+    }
+}
+
+// ThenComparing returns a comparator that compares values by cmp and then by cmp2 if cmp returns
+// EQ. Cmp2 isn't called if cmp doesn't return EQ.
+func ThenComparing(cmp, cmp2 func(interface{}, interface{}) Ordering) func(interface{}, interface{}) Ordering {
+    return func(x, y interface{}) Ordering {
+        o := cmp(x, y)
+        if o == EQ {
+            return cmp2(x, y)
+        } else {
+            return o
+        }
+    }
+}
+
+// Reversed returns a comparator that reverses the order of cmp.
+func Reversed(cmp func(interface{}, interface{}) Ordering) func(interface{}, interface{}) Ordering {
+    return func(x, y interface{}) Ordering {
+        return cmp(x, y).Reverse()
+    }
+}
+
+// Maximum returns the optional greatest element. Maximum compares the elements by the Compare
+// function.
+func Maximum(xs Foldable) *Option {
+    return MaximumBy(Compare, xs)
+}
+
+// MaximumBy returns the optional greatest element. MaximumBy compares the elements by cmp.
+func MaximumBy(cmp func(interface{}, interface{}) Ordering, xs Foldable) *Option {
+    return OptionOrElse(xs.FoldLeft(func(x, y interface{}) interface{} {
+            o := OptionOrElse(x, None())
+            if o.IsNone() || cmp(y, o.Get()) == GT {
+                return Some(y)
+            } else {
+                return o
+            }
+    }, None()), None())
+}
+
+// Minimum returns the optional least element. Minimum compares the elements by the Compare
+// function.
+func Minimum(xs Foldable) *Option {
+    return MinimumBy(Compare, xs)
+}
+
+// MinimumBy returns the optional least element. MinimumBy compares the elements by cmp.
+func MinimumBy(cmp func(interface{}, interface{}) Ordering, xs Foldable) *Option {
+    return OptionOrElse(xs.FoldLeft(func(x, y interface{}) interface{} {
+            o := OptionOrElse(x, None())
+            if o.IsNone() || cmp(y, o.Get()) == LT {
+                return Some(y)
+            } else {
+                return o
+            }
+    }, None()), None())
+}
+
+// SortBy sorts the elements by the keys that are returned by key and returns a list of the sorted
+// elements. The sort is stable.
+func SortBy(key func(interface{}) interface{}, xs Foldable) *List {
+    return SortWith(Comparing(key), xs)
+}
+
+// SortWith sorts the elements by cmp and returns a list of the sorted elements. The sort is stable
+// merge sort.
+func SortWith(cmp func(interface{}, interface{}) Ordering, xs Foldable) *List {
+    var bins []*List
+    xs.FoldLeft(func(x, y interface{}) interface{} {
+            carry := Cons(y, Nil())
+            i := 0
+            for ; i < len(bins) && bins[i] != nil; i++ {
+                carry = mergeLists(cmp, bins[i], carry)
+                bins[i] = nil
+            }
+            if i < len(bins) {
+                bins[i] = carry
+            } else {
+                bins = append(bins, carry)
+            }
+            return x
+    }, nil)
+    ys := Nil()
+    for _, l := range bins {
+        if l != nil {
+            ys = mergeLists(cmp, l, ys)
+        }
+    }
+    return ys
+}
+
+// mergeLists merges two sorted lists. The elements of xs come before the equal elements of ys.
+// The lists must consist of the newly created elements because mergeLists changes their tails.
+func mergeLists(cmp func(interface{}, interface{}) Ordering, xs, ys *List) *List {
+    first := Cons(nil, Nil())
+    prev := first
+    for xs.isCons && ys.isCons {
+        if cmp(ys.head, xs.head) == LT {
+            prev.tail = ys
+            prev = ys
+            ys = ys.tail
+        } else {
+            prev.tail = xs
+            prev = xs
+            xs = xs.tail
+        }
+    }
+    if xs.isCons {
+        prev.tail = xs
+    } else {
+        prev.tail = ys
+    }
+    return first.tail
+}
+
+// SortSliceBy is similar to SortBy but returns a slice instead of a list.
+func SortSliceBy(key func(interface{}) interface{}, xs Foldable) InterfaceSlice {
+    return SortSliceWith(Comparing(key), xs)
+}
+
+// SortSliceWith is similar to SortWith but returns a slice instead of a list. If xs is
+// InterfaceSlice, SortSliceWith sorts a copy of xs.
+func SortSliceWith(cmp func(interface{}, interface{}) Ordering, xs Foldable) InterfaceSlice {
+    var ys InterfaceSlice
+    xs2, isOk := xs.(InterfaceSlice)
+    if isOk {
+        ys = make([]interface{}, len(xs2))
+        copy(ys, xs2)
+    } else {
+        ys = ToSlice(xs)
+    }
+    sort.SliceStable(ys, func(i, j int) bool {
+            return cmp(ys[i], ys[j]) == LT
+    })
+    return ys
+}
+
+func (p *Pair) Compare(other interface{}) int {
+    p2, isOk := other.(*Pair)
+    if isOk {
+        return int(Compare(p.First, p2.First).Append(Compare(p.Second, p2.Second)))
+    } else {
+        return int(compareTypes(p, other))
+    }
+}
+
+func (o *Option) Compare(other interface{}) int {
+    o2, isOk := other.(*Option)
+    if isOk {
+        if o.isSome && o2.isSome {
+            return int(Compare(o.x, o2.x))
+        } else if o.isSome {
+            return int(GT)
+        } else if o2.isSome {
+            return int(LT)
+        } else {
+            return int(EQ)
+        }
+    } else {
+        return int(compareTypes(o, other))
+    }
+}
+
+func (e *Either) Compare(other interface{}) int {
+    e2, isOk := other.(*Either)
+    if isOk {
+        if e.isRight == e2.isRight {
+            return int(Compare(e.x, e2.x))
+        } else if e.isRight {
+            return int(GT)
+        } else {
+            return int(LT)
+        }
+    } else {
+        return int(compareTypes(e, other))
+    }
+}
+
+func (l *List) Compare(other interface{}) int {
+    l2, isOk := other.(*List)
+    if isOk {
+        for l3, l4 := l, l2; ; l3, l4 = l3.tail, l4.tail {
+            if !l3.isCons || !l4.isCons {
+                if l3.isCons {
+                    return int(GT)
+                } else if l4.isCons {
+                    return int(LT)
+                } else {
+                    return int(EQ)
+                }
+            }
+            o := Compare(l3.head, l4.head)
+            if o != EQ {
+                return int(o)
+            }
+        }
+    } else {
+        return int(compareTypes(l, other))
+    }
+}
+
+func (xs InterfaceSlice) Compare(other interface{}) int {
+    ys, isOk := other.(InterfaceSlice)
+    if isOk {
+        for i := 0; i < len(xs) && i < len(ys); i++ {
+            o := Compare(xs[i], ys[i])
+            if o != EQ {
+                return int(o)
+            }
+        }
+        return int(compareInts(len(xs), len(ys)))
+    } else {
+        return int(compareTypes(xs, other))
+    }
+}
+
+func compareInts(x, y int) Ordering {
+    if x < y {
+        return LT
+    } else if x > y {
+        return GT
+    } else {
+        return EQ
+    }
+}
+
+// compareTypes compares the names of the types of x and y.
+func compareTypes(x, y interface{}) Ordering {
+    if y == nil {
+        return GT
+    } else {
+        return compareStrings(reflect.TypeOf(x).String(), reflect.TypeOf(y).String())
+    }
+}
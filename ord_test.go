@@ -0,0 +1,148 @@
+/*
+ * Copyright (c) 2020 Łukasz Szpakowski
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in
+ * all copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+ * THE SOFTWARE.
+ */
+
+package gofun_test
+import (
+    "reflect"
+    "testing"
+    . "gofun"
+)
+
+func TestOrderingAppendMethodReturnsFirstNotEqualOrdering(t *testing.T) {
+    o := EQ.Append(LT).Append(GT)
+    if o != LT {
+        t.Errorf("Ordering.Append method result is %v; want %v", o, LT)
+    }
+}
+
+func TestCompareFunctionComparesPairs(t *testing.T) {
+    o := Compare(NewPair(1, "b"), NewPair(1, "a"))
+    if o != GT {
+        t.Errorf("Compare function result is %v; want %v", o, GT)
+    }
+}
+
+func TestCompareFunctionComparesOptions(t *testing.T) {
+    o := Compare(None(), Some(1))
+    if o != LT {
+        t.Errorf("Compare function result is %v; want %v", o, LT)
+    }
+}
+
+func TestCompareFunctionComparesLists(t *testing.T) {
+    o := Compare(Cons(1, Cons(2, Nil())), Cons(1, Cons(2, Cons(0, Nil()))))
+    if o != LT {
+        t.Errorf("Compare function result is %v; want %v", o, LT)
+    }
+}
+
+func TestComparingFunctionComparesKeys(t *testing.T) {
+    cmp := Comparing(func(x interface{}) interface{} { return PairOrElse(x, NewPair(0, 0)).Second })
+    o := cmp(NewPair(1, 3), NewPair(2, 3))
+    if o != EQ {
+        t.Errorf("Comparing function result is %v; want %v", o, EQ)
+    }
+}
+
+func TestThenComparingFunctionComparesBySecondComparator(t *testing.T) {
+    cmp := ThenComparing(Comparing(func(x interface{}) interface{} {
+            return len(StringOrElse(x, ""))
+    }), Reversed(Compare))
+    o := cmp("ab", "ac")
+    if o != GT {
+        t.Errorf("ThenComparing function result is %v; want %v", o, GT)
+    }
+}
+
+func TestThenComparingFunctionDoesNotCallSecondComparatorForDecidedOrder(t *testing.T) {
+    n := 0
+    cmp := ThenComparing(Compare, func(x, y interface{}) Ordering {
+            n++
+            return EQ
+    })
+    o := cmp(1, 2)
+    if o != LT {
+        t.Errorf("ThenComparing function result is %v; want %v", o, LT)
+    }
+    if n != 0 {
+        t.Errorf("number of calls is %v; want %v", n, 0)
+    }
+}
+
+func TestSortByFunctionSortsListStably(t *testing.T) {
+    xs := SortBy(func(x interface{}) interface{} {
+            return PairOrElse(x, NewPair(0, 0)).First
+    }, Cons(NewPair(2, "a"), Cons(NewPair(1, "b"), Cons(NewPair(2, "c"), Cons(NewPair(1, "d"), Cons(NewPair(0, "e"), Nil()))))))
+    ys := Cons(NewPair(0, "e"), Cons(NewPair(1, "b"), Cons(NewPair(1, "d"), Cons(NewPair(2, "a"), Cons(NewPair(2, "c"), Nil())))))
+    if !reflect.DeepEqual(xs, ys) {
+        t.Errorf("SortBy function result is %v; want %v", xs, ys)
+    }
+}
+
+func TestSortWithFunctionSortsInterfaceSlice(t *testing.T) {
+    xs := SortWith(Reversed(Compare), InterfaceSlice([]interface{} { 3, 1, 4, 1, 5, 9, 2, 6 }))
+    ys := ToList(InterfaceSlice([]interface{} { 9, 6, 5, 4, 3, 2, 1, 1 }))
+    if !reflect.DeepEqual(xs, ys) {
+        t.Errorf("SortWith function result is %v; want %v", xs, ys)
+    }
+}
+
+func TestSortWithFunctionSortsEmptyList(t *testing.T) {
+    xs := SortWith(Compare, Nil())
+    if !reflect.DeepEqual(xs, Nil()) {
+        t.Errorf("SortWith function result is %v; want %v", xs, Nil())
+    }
+}
+
+func TestSortSliceWithFunctionDoesNotChangeInterfaceSlice(t *testing.T) {
+    xs := InterfaceSlice([]interface{} { "c", "a", "b" })
+    ys := SortSliceWith(Compare, xs)
+    if !reflect.DeepEqual(ys, InterfaceSlice([]interface{} { "a", "b", "c" })) {
+        t.Errorf("SortSliceWith function result is %v; want %v", ys, InterfaceSlice([]interface{} { "a", "b", "c" }))
+    }
+    if !reflect.DeepEqual(xs, InterfaceSlice([]interface{} { "c", "a", "b" })) {
+        t.Errorf("slice is %v; want %v", xs, InterfaceSlice([]interface{} { "c", "a", "b" }))
+    }
+}
+
+func TestMaximumFunctionReturnsGreatestElement(t *testing.T) {
+    o := Maximum(InterfaceSlice([]interface{} { 3, 7, 2 }))
+    if !reflect.DeepEqual(o, Some(7)) {
+        t.Errorf("Maximum function result is %v; want %v", o, Some(7))
+    }
+}
+
+func TestMinimumByFunctionReturnsLeastElement(t *testing.T) {
+    o := MinimumBy(Comparing(func(x interface{}) interface{} {
+            return PairOrElse(x, NewPair(0, 0)).Second
+    }), Cons(NewPair(1, 5), Cons(NewPair(2, 3), Cons(NewPair(3, 3), Nil()))))
+    if !reflect.DeepEqual(o, Some(NewPair(2, 3))) {
+        t.Errorf("MinimumBy function result is %v; want %v", o, Some(NewPair(2, 3)))
+    }
+}
+
+func TestMinimumFunctionReturnsNoneForEmptyList(t *testing.T) {
+    o := Minimum(Nil())
+    if !reflect.DeepEqual(o, None()) {
+        t.Errorf("Minimum function result is %v; want %v", o, None())
+    }
+}
@@ -0,0 +1,174 @@
+/*
+ * Copyright (c) 2020 Łukasz Szpakowski
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in
+ * all copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+ * THE SOFTWARE.
+ */
+
+package gofun
+import (
+    "context"
+    "runtime"
+    "sync"
+    "sync/atomic"
+)
+
+// parCheckInterval is the number of elements that are processed between checks of the context.
+const parCheckInterval = 1024
+
+func parWorkers(workers, n int) int {
+    if workers <= 0 {
+        workers = runtime.GOMAXPROCS(0)
+    }
+    if workers > n {
+        workers = n
+    }
+    return workers
+}
+
+// parEach calls f for the indices from zero to n - 1 in at most workers goroutines. The goroutines
+// take the next index when they are finished with the previous one. If f returns false or ctx is
+// cancelled, parEach doesn't call f for the remaining indices. If f panics, parEach panics with the
+// same value after the goroutines are finished.
+func parEach(ctx context.Context, n int, workers int, f func(int) bool) {
+    k := parWorkers(workers, n)
+    var next atomic.Int64
+    var isStopped atomic.Bool
+    panics := make([]interface{}, k)
+    var wg sync.WaitGroup
+    for w := 0; w < k; w++ {
+        wg.Add(1)
+        go func(w int) {
+            defer wg.Done()
+            defer func() {
+                panics[w] = recover()
+                if panics[w] != nil {
+                    isStopped.Store(true)
+                }
+            }()
+            for !isStopped.Load() && ctx.Err() == nil {
+                i := int(next.Add(1) - 1)
+                if i >= n {
+                    break
+                }
+                if !f(i) {
+                    isStopped.Store(true)
+                }
+            }
+        }(w)
+    }
+    wg.Wait()
+    for _, r := range panics {
+        if r != nil {
+            panic(r)
+        }
+    }
+}
+
+// ParFoldMap is similar to FoldMap but splits xs into chunks and folds them in at most workers
+// goroutines. The results of the chunks are combined in order, so m must be associative but needn't
+// be commutative. ParFoldMap handles workers, ctx and panics like ParMap, so it stops folding the
+// remaining chunks if ctx is cancelled or f or m panics.
+func ParFoldMap(ctx context.Context, f func(interface{}) interface{}, m Monoid, xs InterfaceSlice, workers int) (interface{}, error) {
+    size := parCheckInterval
+    n := parWorkers(workers, len(xs))
+    if n > 0 && (len(xs) + n - 1) / n < size {
+        size = (len(xs) + n - 1) / n
+    }
+    ys := make([]interface{}, (len(xs) + size - 1) / size)
+    parEach(ctx, len(ys), workers, func(i int) bool {
+            end := (i + 1) * size
+            if end > len(xs) {
+                end = len(xs)
+            }
+            ys[i] = FoldMap(f, m, xs[i * size:end])
+            return true
+    })
+    err := ctx.Err()
+    if err != nil {
+        return nil, err
+    }
+    return mconcat(m, ys), nil
+}
+
+// ParMap is similar to the Map method of InterfaceSlice but applies f to the elements in at most
+// workers goroutines. The result has the same order as xs. If workers is less than one, ParMap uses
+// GOMAXPROCS goroutines. If ctx is cancelled, ParMap doesn't apply f to the remaining elements and
+// returns the context error. If f panics, ParMap panics with the same value.
+func ParMap(ctx context.Context, f func(interface{}) interface{}, xs InterfaceSlice, workers int) (InterfaceSlice, error) {
+    ys := make([]interface{}, len(xs))
+    parEach(ctx, len(xs), workers, func(i int) bool {
+            ys[i] = f(xs[i])
+            return true
+    })
+    err := ctx.Err()
+    if err != nil {
+        return nil, err
+    }
+    return InterfaceSlice(ys), nil
+}
+
+// ParBind is similar to the Bind method of InterfaceSlice but applies f to the elements in at most
+// workers goroutines. The results of f are concatenated in the order of xs and the results that
+// aren't InterfaceSlice are skipped. ParBind handles workers, ctx and panics like ParMap.
+func ParBind(ctx context.Context, f func(interface{}) Monad, xs InterfaceSlice, workers int) (InterfaceSlice, error) {
+    yss := make([]interface{}, len(xs))
+    parEach(ctx, len(xs), workers, func(i int) bool {
+            yss[i] = f(xs[i])
+            return true
+    })
+    err := ctx.Err()
+    if err != nil {
+        return nil, err
+    }
+    zs := make([]interface{}, 0, len(xs))
+    for _, ys := range yss {
+        ys2, isOk := ys.(InterfaceSlice)
+        if isOk {
+            zs = append(zs, ys2...)
+        }
+    }
+    return InterfaceSlice(zs), nil
+}
+
+// ParMapEither applies f to the elements of xs in at most workers goroutines and returns Right with
+// the right values in the order of xs. If f returns Left, ParMapEither doesn't apply f to the
+// remaining elements and returns Left. If f returns Left for several elements, ParMapEither returns
+// Left for the first element of them. If ctx is cancelled, ParMapEither returns Left with the
+// context error. ParMapEither handles workers and panics like ParMap.
+func ParMapEither(ctx context.Context, f func(interface{}) *Either, xs InterfaceSlice, workers int) *Either {
+    ys := make([]*Either, len(xs))
+    parEach(ctx, len(xs), workers, func(i int) bool {
+            ys[i] = f(xs[i])
+            return ys[i].IsRight()
+    })
+    for _, y := range ys {
+        if y != nil && y.IsLeft() {
+            return y
+        }
+    }
+    err := ctx.Err()
+    if err != nil {
+        return Left(err)
+    }
+    zs := make([]interface{}, len(ys))
+    for i, y := range ys {
+        zs[i] = y.GetRight()
+    }
+    return Right(InterfaceSlice(zs))
+}
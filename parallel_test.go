@@ -0,0 +1,253 @@
+/*
+ * Copyright (c) 2020 Łukasz Szpakowski
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in
+ * all copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+ * THE SOFTWARE.
+ */
+
+package gofun_test
+import (
+    "context"
+    "reflect"
+    "sync/atomic"
+    "testing"
+    "time"
+    . "gofun"
+)
+
+func TestParFoldMapFunctionFoldsInterfaceSlice(t *testing.T) {
+    xs := make([]interface{}, 100000)
+    for i := range xs {
+        xs[i] = i
+    }
+    x, err := ParFoldMap(context.Background(), func(x interface{}) interface{} {
+            return IntOrElse(x, 0) * 2
+    }, SumMonoid[int](), InterfaceSlice(xs), 4)
+    if err != nil {
+        t.Errorf("ParFoldMap function second result is %v; want %v", err, nil)
+    }
+    if !reflect.DeepEqual(x, 99999 * 100000) {
+        t.Errorf("ParFoldMap function first result is %v; want %v", x, 99999 * 100000)
+    }
+}
+
+func TestParFoldMapFunctionCombinesChunksInOrder(t *testing.T) {
+    xs := make([]interface{}, 1000)
+    ys := make([]interface{}, 1000)
+    for i := range xs {
+        xs[i] = i
+        ys[i] = i + 1
+    }
+    x, err := ParFoldMap(context.Background(), func(x interface{}) interface{} {
+            return InterfaceSlice([]interface{} { IntOrElse(x, 0) + 1 })
+    }, InterfaceSliceMonoid(), InterfaceSlice(xs), 7)
+    if err != nil {
+        t.Errorf("ParFoldMap function second result is %v; want %v", err, nil)
+    }
+    if !reflect.DeepEqual(x, InterfaceSlice(ys)) {
+        t.Errorf("ParFoldMap function first result is %v; want %v", x, InterfaceSlice(ys))
+    }
+}
+
+func TestParFoldMapFunctionFoldsEmptyInterfaceSlice(t *testing.T) {
+    x, err := ParFoldMap(context.Background(), func(x interface{}) interface{} {
+            return x
+    }, StringMonoid(), InterfaceSlice([]interface{} {}), 0)
+    if err != nil {
+        t.Errorf("ParFoldMap function second result is %v; want %v", err, nil)
+    }
+    if !reflect.DeepEqual(x, "") {
+        t.Errorf("ParFoldMap function first result is %v; want %v", x, "")
+    }
+}
+
+func TestParFoldMapFunctionReturnsErrorForCancelledContext(t *testing.T) {
+    ctx, cancel := context.WithCancel(context.Background())
+    cancel()
+    _, err := ParFoldMap(ctx, func(x interface{}) interface{} {
+            return x
+    }, SumMonoid[int](), InterfaceSlice([]interface{} { 1, 2, 3 }), 2)
+    if err != context.Canceled {
+        t.Errorf("ParFoldMap function second result is %v; want %v", err, context.Canceled)
+    }
+}
+
+func TestParFoldMapFunctionPropagatesPanic(t *testing.T) {
+    defer func() {
+        r := recover()
+        if !reflect.DeepEqual(r, "error") {
+            t.Errorf("recovered value is %v; want %v", r, "error")
+        }
+    }()
+    ParFoldMap(context.Background(), func(x interface{}) interface{} {
+            if IntOrElse(x, 0) == 3 {
+                panic("error")
+            }
+            return x
+    }, SumMonoid[int](), InterfaceSlice([]interface{} { 1, 2, 3, 4 }), 2)
+}
+
+func TestParFoldMapFunctionReturnsNilAndErrorForEmptySliceAndCancelledContext(t *testing.T) {
+    ctx, cancel := context.WithCancel(context.Background())
+    cancel()
+    x, err := ParFoldMap(ctx, func(x interface{}) interface{} {
+            return x
+    }, SumMonoid[int](), InterfaceSlice([]interface{} {}), 2)
+    if x != nil {
+        t.Errorf("ParFoldMap function first result is %v; want %v", x, nil)
+    }
+    if err != context.Canceled {
+        t.Errorf("ParFoldMap function second result is %v; want %v", err, context.Canceled)
+    }
+}
+
+func TestParFoldMapFunctionStopsFoldingAfterPanic(t *testing.T) {
+    xs := make([]interface{}, 100000)
+    for i := range xs {
+        xs[i] = i
+    }
+    var n atomic.Int64
+    func() {
+        defer func() {
+            recover()
+        }()
+        ParFoldMap(context.Background(), func(x interface{}) interface{} {
+                n.Add(1)
+                if IntOrElse(x, 0) == 0 {
+                    panic("error")
+                }
+                return x
+        }, SumMonoid[int](), InterfaceSlice(xs), 2)
+    }()
+    if n.Load() >= int64(len(xs)) {
+        t.Errorf("number of calls is %v; want less than %v", n.Load(), len(xs))
+    }
+}
+
+func TestParMapFunctionMapsInterfaceSlice(t *testing.T) {
+    xs := make([]interface{}, 1000)
+    ys := make([]interface{}, 1000)
+    for i := range xs {
+        xs[i] = i
+        ys[i] = i * 2
+    }
+    zs, err := ParMap(context.Background(), func(x interface{}) interface{} {
+            return IntOrElse(x, 0) * 2
+    }, InterfaceSlice(xs), 3)
+    if err != nil {
+        t.Errorf("ParMap function second result is %v; want %v", err, nil)
+    }
+    if !reflect.DeepEqual(zs, InterfaceSlice(ys)) {
+        t.Errorf("ParMap function first result is %v; want %v", zs, InterfaceSlice(ys))
+    }
+}
+
+func TestParMapFunctionLimitsNumberOfGoroutines(t *testing.T) {
+    var count, maxCount atomic.Int32
+    xs := make([]interface{}, 100)
+    _, err := ParMap(context.Background(), func(x interface{}) interface{} {
+            c := count.Add(1)
+            for {
+                m := maxCount.Load()
+                if c <= m || maxCount.CompareAndSwap(m, c) {
+                    break
+                }
+            }
+            time.Sleep(time.Millisecond)
+            count.Add(-1)
+            return x
+    }, InterfaceSlice(xs), 2)
+    if err != nil {
+        t.Errorf("ParMap function second result is %v; want %v", err, nil)
+    }
+    if maxCount.Load() > 2 {
+        t.Errorf("maximal number of goroutines is %v; want at most %v", maxCount.Load(), 2)
+    }
+}
+
+func TestParMapFunctionReturnsErrorForCancelledContext(t *testing.T) {
+    ctx, cancel := context.WithCancel(context.Background())
+    cancel()
+    var count atomic.Int32
+    _, err := ParMap(ctx, func(x interface{}) interface{} {
+            count.Add(1)
+            return x
+    }, InterfaceSlice([]interface{} { 1, 2, 3 }), 2)
+    if err != context.Canceled {
+        t.Errorf("ParMap function second result is %v; want %v", err, context.Canceled)
+    }
+    if count.Load() != 0 {
+        t.Errorf("number of calls is %v; want %v", count.Load(), 0)
+    }
+}
+
+func TestParBindFunctionBindsInterfaceSlice(t *testing.T) {
+    ys, err := ParBind(context.Background(), func(x interface{}) Monad {
+            if IntOrElse(x, 0) == 2 {
+                return None()
+            }
+            return InterfaceSlice([]interface{} { x, IntOrElse(x, 0) * 10 })
+    }, InterfaceSlice([]interface{} { 1, 2, 3, 4 }), 2)
+    if err != nil {
+        t.Errorf("ParBind function second result is %v; want %v", err, nil)
+    }
+    if !reflect.DeepEqual(ys, InterfaceSlice([]interface{} { 1, 10, 3, 30, 4, 40 })) {
+        t.Errorf("ParBind function first result is %v; want %v", ys, InterfaceSlice([]interface{} { 1, 10, 3, 30, 4, 40 }))
+    }
+}
+
+func TestParMapEitherFunctionReturnsRight(t *testing.T) {
+    e := ParMapEither(context.Background(), func(x interface{}) *Either {
+            return Right(IntOrElse(x, 0) + 1)
+    }, InterfaceSlice([]interface{} { 1, 2, 3 }), 2)
+    if !reflect.DeepEqual(e, Right(InterfaceSlice([]interface{} { 2, 3, 4 }))) {
+        t.Errorf("ParMapEither function result is %v; want %v", e, Right(InterfaceSlice([]interface{} { 2, 3, 4 })))
+    }
+}
+
+func TestParMapEitherFunctionStopsAfterLeft(t *testing.T) {
+    var count atomic.Int32
+    xs := make([]interface{}, 1000)
+    for i := range xs {
+        xs[i] = i
+    }
+    e := ParMapEither(context.Background(), func(x interface{}) *Either {
+            count.Add(1)
+            if IntOrElse(x, 0) == 0 {
+                return Left("error")
+            }
+            return Right(x)
+    }, InterfaceSlice(xs), 1)
+    if !reflect.DeepEqual(e, Left("error")) {
+        t.Errorf("ParMapEither function result is %v; want %v", e, Left("error"))
+    }
+    if count.Load() != 1 {
+        t.Errorf("number of calls is %v; want %v", count.Load(), 1)
+    }
+}
+
+func TestParMapEitherFunctionReturnsLeftForCancelledContext(t *testing.T) {
+    ctx, cancel := context.WithCancel(context.Background())
+    cancel()
+    e := ParMapEither(ctx, func(x interface{}) *Either {
+            return Right(x)
+    }, InterfaceSlice([]interface{} { 1, 2, 3 }), 2)
+    if !reflect.DeepEqual(e, Left(context.Canceled)) {
+        t.Errorf("ParMapEither function result is %v; want %v", e, Left(context.Canceled))
+    }
+}
@@ -0,0 +1,106 @@
+/*
+ * Copyright (c) 2020 Łukasz Szpakowski
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in
+ * all copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+ * THE SOFTWARE.
+ */
+
+package gofun
+import "fmt"
+
+// Queue represents persistent FIFO queues. Queue is a banker's queue that has a lazy front stream
+// and a rear list. When the rear list becomes longer than the front stream, the reversed rear list
+// is lazily appended to the front stream, so PushBack and PopFront take amortized O(1) time even
+// if the old versions of the queue are used.
+type Queue struct {
+    front *Stream
+    frontLen int
+    rear *List
+    rearLen int
+}
+
+// QueueOrElse returns x if x is Queue pointer, otherwise y.
+func QueueOrElse(x interface{}, y *Queue) *Queue {
+    return OrElse(x, y)
+}
+
+// NewQueue creates an empty queue.
+func NewQueue() *Queue {
+    return &Queue { front: EmptyStream(), frontLen: 0, rear: Nil(), rearLen: 0 }
+}
+
+// ToQueue creates a queue from the elements of xs.
+func ToQueue(xs Foldable) *Queue {
+    return QueueOrElse(xs.FoldLeft(func(x, y interface{}) interface{} {
+            return QueueOrElse(x, NewQueue()).PushBack(y)
+    }, NewQueue()), NewQueue())
+}
+
+func newQueue(front *Stream, frontLen int, rear *List, rearLen int) *Queue {
+    if rearLen <= frontLen {
+        return &Queue { front: front, frontLen: frontLen, rear: rear, rearLen: rearLen }
+    }
+    front2 := front.concat(func() *Stream {
+            return reverseStream(rear)
+    })
+    return &Queue { front: front2, frontLen: frontLen + rearLen, rear: Nil(), rearLen: 0 }
+}
+
+// Len returns the number of elements.
+func (q *Queue) Len() int {
+    return q.frontLen + q.rearLen
+}
+
+// IsEmpty returns true if the queue is empty, otherwise false.
+func (q *Queue) IsEmpty() bool {
+    return q.frontLen == 0
+}
+
+// PushBack returns the queue with the element at the back.
+func (q *Queue) PushBack(x interface{}) *Queue {
+    return newQueue(q.front, q.frontLen, Cons(x, q.rear), q.rearLen + 1)
+}
+
+// Front returns the optional first element.
+func (q *Queue) Front() *Option {
+    return q.front.HeadOption()
+}
+
+// PopFront returns the optional pair of the first element and the queue of the rest elements.
+func (q *Queue) PopFront() *Option {
+    if q.frontLen > 0 {
+        return Some(NewPair(q.front.Head(), newQueue(q.front.Tail(), q.frontLen - 1, q.rear, q.rearLen)))
+    } else {
+        return None()
+    }
+}
+
+func (q *Queue) String() string {
+    s := "Queue["
+    isFirst := true
+    q.FoldLeft(func(x, y interface{}) interface{} {
+            if !isFirst {
+                s += " "
+            }
+            s += fmt.Sprintf("%v", y)
+            isFirst = false
+            return x
+    }, nil)
+    s += "]"
+    return s
+}
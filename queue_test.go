@@ -0,0 +1,121 @@
+/*
+ * Copyright (c) 2020 Łukasz Szpakowski
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in
+ * all copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+ * THE SOFTWARE.
+ */
+
+package gofun_test
+import (
+    "reflect"
+    "testing"
+    . "gofun"
+)
+
+func popQueue(q *Queue) (interface{}, *Queue) {
+    p := PairOrElse(q.PopFront().GetOrElse(func() interface{} {
+            return NewPair(nil, nil)
+    }), nil)
+    return p.First, QueueOrElse(p.Second, nil)
+}
+
+func TestQueuePopFrontMethodReturnsElementsInOrder(t *testing.T) {
+    q := NewQueue()
+    for i := 0; i < 1000; i++ {
+        q = q.PushBack(i)
+        if i % 3 == 0 {
+            var x interface{}
+            x, q = popQueue(q)
+            if !reflect.DeepEqual(x, i / 3) {
+                t.Fatalf("Queue.PopFront method result is %v; want %v", x, i / 3)
+            }
+        }
+    }
+    for i := 334; i < 1000; i++ {
+        var x interface{}
+        x, q = popQueue(q)
+        if !reflect.DeepEqual(x, i) {
+            t.Fatalf("Queue.PopFront method result is %v; want %v", x, i)
+        }
+    }
+    if q.IsEmpty() != true {
+        t.Errorf("Queue.IsEmpty method result is %v; want %v", q.IsEmpty(), true)
+    }
+    if !reflect.DeepEqual(q.PopFront(), None()) {
+        t.Errorf("Queue.PopFront method result is %v; want %v", q.PopFront(), None())
+    }
+}
+
+func TestQueuePushBackMethodDoesNotChangeQueue(t *testing.T) {
+    q := ToQueue(InterfaceSlice([]interface{} { 1, 2, 3 }))
+    q2 := q.PushBack(4)
+    _, q3 := popQueue(q)
+    if !reflect.DeepEqual(ToSlice(q), InterfaceSlice([]interface{} { 1, 2, 3 })) {
+        t.Errorf("Queue.PushBack method result is %v; want %v", q, InterfaceSlice([]interface{} { 1, 2, 3 }))
+    }
+    if !reflect.DeepEqual(ToSlice(q2), InterfaceSlice([]interface{} { 1, 2, 3, 4 })) {
+        t.Errorf("Queue.PushBack method result is %v; want %v", q2, InterfaceSlice([]interface{} { 1, 2, 3, 4 }))
+    }
+    if !reflect.DeepEqual(ToSlice(q3), InterfaceSlice([]interface{} { 2, 3 })) {
+        t.Errorf("Queue.PopFront method result is %v; want %v", q3, InterfaceSlice([]interface{} { 2, 3 }))
+    }
+    if q2.Len() != 4 {
+        t.Errorf("Queue.Len method result is %v; want %v", q2.Len(), 4)
+    }
+}
+
+func TestQueueFoldRightMethodFoldsElementsInOrder(t *testing.T) {
+    q := ToQueue(InterfaceSlice([]interface{} { 1, 2, 3 })).PushBack(4)
+    if !reflect.DeepEqual(ToList(q), Cons(1, Cons(2, Cons(3, Cons(4, Nil()))))) {
+        t.Errorf("Queue.FoldRight method result is %v; want %v", ToList(q), Cons(1, Cons(2, Cons(3, Cons(4, Nil())))))
+    }
+}
+
+func TestFilterFunctionFiltersQueue(t *testing.T) {
+    l := Filter(func(x interface{}) bool {
+            return IntOrElse(x, 0) % 2 == 0
+    }, ToQueue(InterfaceSlice([]interface{} { 1, 2, 3, 4, 5, 6 })))
+    if !reflect.DeepEqual(l, Cons(2, Cons(4, Cons(6, Nil())))) {
+        t.Errorf("Filter function result is %v; want %v", l, Cons(2, Cons(4, Cons(6, Nil()))))
+    }
+}
+
+func TestFoldLeftMFunctionFoldsQueue(t *testing.T) {
+    o := FoldLeftM(func(x, y interface{}) Monad {
+            return Some(IntOrElse(x, 0) * 10 + IntOrElse(y, 0))
+    }, 0, ToQueue(InterfaceSlice([]interface{} { 1, 2, 3 })), OptionUnit)
+    if !reflect.DeepEqual(o, Some(123)) {
+        t.Errorf("FoldLeftM function result is %v; want %v", o, Some(123))
+    }
+}
+
+func TestQueueMapMethodMapsElements(t *testing.T) {
+    q := QueueOrElse(ToQueue(InterfaceSlice([]interface{} { 1, 2 })).PushBack(3).Map(inc), nil)
+    if !reflect.DeepEqual(ToSlice(q), InterfaceSlice([]interface{} { 2, 3, 4 })) {
+        t.Errorf("Queue.Map method result is %v; want %v", q, InterfaceSlice([]interface{} { 2, 3, 4 }))
+    }
+}
+
+func TestQueueBindMethodBindsElements(t *testing.T) {
+    q := ToQueue(InterfaceSlice([]interface{} { 1, 2 })).Bind(func(x interface{}) Monad {
+            return QueueUnit(x).(*Queue).PushBack(IntOrElse(x, 0) * 10)
+    })
+    if !reflect.DeepEqual(ToSlice(FoldableOrElse(q, nil)), InterfaceSlice([]interface{} { 1, 10, 2, 20 })) {
+        t.Errorf("Queue.Bind method result is %v; want %v", q, InterfaceSlice([]interface{} { 1, 10, 2, 20 }))
+    }
+}
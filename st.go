@@ -22,8 +22,16 @@
 
 package gofun
 
-// ST represents a state monads.
-type ST func(interface{}) (interface{}, interface{})
+// ST represents a state monads. ST is represented as data that RunST interprets in a loop, so
+// long chains of binding don't grow the stack. The zero value of ST returns the state and nil.
+//
+// ST was a function type before, so this change breaks the API: a conversion ST(f) must be
+// replaced by NewST(f) and a call st(s) must be replaced by RunST(st, s).
+type ST struct {
+    step func(interface{}) (interface{}, interface{})
+    m *ST
+    f func(interface{}) Monad
+}
 
 // STOrElse returns x if x is ST, otherwise y.
 func STOrElse(x interface{}, y ST) ST {
@@ -35,21 +43,49 @@ func STOrElse(x interface{}, y ST) ST {
     }
 }
 
+// NewST creates the ST monad from a function that takes the state and returns a new state and
+// the result. NewST replaces the conversion to ST, which isn't possible since ST became a struct.
+func NewST(f func(interface{}) (interface{}, interface{})) ST {
+    return ST { step: f }
+}
+
 // RunST runs the ST monad.
 func RunST(st ST, x interface{}) (interface{}, interface{}) {
-    return st(x)
+    s := x
+    var y interface{}
+    fs := make([]func(interface{}) Monad, 0)
+    for {
+        for st.m != nil {
+            fs = append(fs, st.f)
+            st = *st.m
+        }
+        if st.step != nil {
+            s, y = st.step(s)
+        } else {
+            y = nil
+        }
+        isNext := false
+        for !isNext {
+            if len(fs) == 0 {
+                return s, y
+            }
+            f := fs[len(fs) - 1]
+            fs = fs[:len(fs) - 1]
+            st, isNext = f(y).(ST)
+        }
+    }
 }
 
 // GetST returns the ST monad with the state.
 func GetST() ST {
-    return ST(func(s interface{}) (interface{}, interface{}) {
+    return NewST(func(s interface{}) (interface{}, interface{}) {
             return s, s
     })
 }
 
 // SetST sets a new state.
 func SetST(newS interface{}) ST {
-    return ST(func(s interface{}) (interface{}, interface{}) {
+    return NewST(func(s interface{}) (interface{}, interface{}) {
             return newS, struct{} {}
     })
 }
@@ -0,0 +1,94 @@
+/*
+ * Copyright (c) 2020 Łukasz Szpakowski
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in
+ * all copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+ * THE SOFTWARE.
+ */
+
+package gofun_test
+import (
+    "reflect"
+    "runtime/debug"
+    "testing"
+    . "gofun"
+)
+
+func TestRunSTFunctionRunsLeftNestedBinds(t *testing.T) {
+    old := debug.SetMaxStack(1 << 20)
+    defer debug.SetMaxStack(old)
+    m := STUnit(0)
+    for i := 0; i < 300000; i++ {
+        m = m.Bind(func(x interface{}) Monad {
+                return GetST().Bind(func(s interface{}) Monad {
+                        return SetST(IntOrElse(s, 0) + 1).Map(func(y interface{}) interface{} {
+                                return IntOrElse(x, 0) + 2
+                        }).(Monad)
+                })
+        })
+    }
+    s, x := RunST(STOrElse(m, GetST()), 0)
+    if !reflect.DeepEqual(s, 300000) {
+        t.Errorf("RunST function first result is %v; want %v", s, 300000)
+    }
+    if !reflect.DeepEqual(x, 600000) {
+        t.Errorf("RunST function second result is %v; want %v", x, 600000)
+    }
+}
+
+func TestRunSTFunctionRunsLeftNestedMaps(t *testing.T) {
+    old := debug.SetMaxStack(1 << 20)
+    defer debug.SetMaxStack(old)
+    var m Functor = GetST()
+    for i := 0; i < 300000; i++ {
+        m = m.Map(func(x interface{}) interface{} {
+                return IntOrElse(x, 0) + 1
+        })
+    }
+    s, x := RunST(STOrElse(m, GetST()), 1)
+    if !reflect.DeepEqual(s, 1) {
+        t.Errorf("RunST function first result is %v; want %v", s, 1)
+    }
+    if !reflect.DeepEqual(x, 300001) {
+        t.Errorf("RunST function second result is %v; want %v", x, 300001)
+    }
+}
+
+func TestRunSTFunctionReturnsResultForBindWithoutST(t *testing.T) {
+    m := GetST().Bind(func(x interface{}) Monad {
+            return Some(2)
+    }).Bind(func(x interface{}) Monad {
+            return STUnit(IntOrElse(x, 0) + 1)
+    })
+    s, x := RunST(STOrElse(m, GetST()), 1)
+    if !reflect.DeepEqual(s, 1) {
+        t.Errorf("RunST function first result is %v; want %v", s, 1)
+    }
+    if !reflect.DeepEqual(x, 2) {
+        t.Errorf("RunST function second result is %v; want %v", x, 2)
+    }
+}
+
+func TestRunSTFunctionRunsZeroST(t *testing.T) {
+    s, x := RunST(ST {}, 1)
+    if !reflect.DeepEqual(s, 1) {
+        t.Errorf("RunST function first result is %v; want %v", s, 1)
+    }
+    if x != nil {
+        t.Errorf("RunST function second result is %v; want %v", x, nil)
+    }
+}
@@ -0,0 +1,251 @@
+/*
+ * Copyright (c) 2020 Łukasz Szpakowski
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in
+ * all copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+ * THE SOFTWARE.
+ */
+
+package gofun
+import "fmt"
+
+// Stream represents lazy lists that can be infinite. The tail of Stream is computed when it is
+// needed for the first time and then it is remembered.
+type Stream struct {
+    isCons bool
+    head interface{}
+    tail *Lazy
+}
+
+// StreamOrElse returns x if x is a pointer to Stream, otherwise y.
+func StreamOrElse(x interface{}, y *Stream) *Stream {
+    return OrElse(x, y)
+}
+
+// EmptyStream creates an empty stream.
+func EmptyStream() *Stream {
+    return &Stream { isCons: false, head: nil, tail: nil }
+}
+
+// ConsStream creates a stream with a first element and a tail that is computed by a function.
+func ConsStream(head interface{}, tail func() *Stream) *Stream {
+    return &Stream { isCons: true, head: head, tail: Defer(func() interface{} {
+            return tail()
+    }) }
+}
+
+// consStream creates a stream with a first element and a computed tail.
+func consStream(head interface{}, tail *Stream) *Stream {
+    return &Stream { isCons: true, head: head, tail: LazyUnit(tail).(*Lazy) }
+}
+
+// reverseStream creates a stream of the elements of xs in reverse order.
+func reverseStream(xs Foldable) *Stream {
+    return StreamOrElse(xs.FoldLeft(func(x, y interface{}) interface{} {
+            return consStream(y, StreamOrElse(x, EmptyStream()))
+    }, EmptyStream()), EmptyStream())
+}
+
+// ToStream creates a stream from the elements of xs.
+func ToStream(xs Foldable) *Stream {
+    return sliceStream(ToSlice(xs), 0, nil)
+}
+
+// sliceStream creates a stream from the elements of xs from i. The tail of the last element is
+// end or an empty stream if end is nil.
+func sliceStream(xs InterfaceSlice, i int, end func() *Stream) *Stream {
+    if i < len(xs) {
+        return ConsStream(xs[i], func() *Stream {
+                return sliceStream(xs, i + 1, end)
+        })
+    } else if end != nil {
+        return end()
+    } else {
+        return EmptyStream()
+    }
+}
+
+// Iterate creates an infinite stream of x, f(x), f(f(x)), and so on.
+func Iterate(f func(interface{}) interface{}, x interface{}) *Stream {
+    return ConsStream(x, func() *Stream {
+            return Iterate(f, f(x))
+    })
+}
+
+// Repeat creates an infinite stream of x.
+func Repeat(x interface{}) *Stream {
+    s := &Stream { isCons: true, head: x }
+    s.tail = LazyUnit(s).(*Lazy)
+    return s
+}
+
+// Cycle creates an infinite stream that repeats the elements of xs. If xs is empty, Cycle returns
+// an empty stream.
+func Cycle(xs Foldable) *Stream {
+    ys := ToSlice(xs)
+    if len(ys) == 0 {
+        return EmptyStream()
+    }
+    var s *Stream
+    s = sliceStream(ys, 0, func() *Stream {
+            return s
+    })
+    return s
+}
+
+// Unfold creates a stream from a seed. F returns None to end the stream or a pair of the next
+// element and the next seed.
+func Unfold(f func(interface{}) *Option, z interface{}) *Stream {
+    p, isOk := f(z).GetOrElse(func() interface{} {
+            return nil
+    }).(*Pair)
+    if isOk {
+        return ConsStream(p.First, func() *Stream {
+                return Unfold(f, p.Second)
+        })
+    } else {
+        return EmptyStream()
+    }
+}
+
+// IsEmpty returns true if stream is empty, otherwise false.
+func (s *Stream) IsEmpty() bool {
+    return !s.isCons
+}
+
+// IsCons returns true if stream isn't empty, otherwise false.
+func (s *Stream) IsCons() bool {
+    return s.isCons
+}
+
+// Head returns the first element.
+func (s *Stream) Head() interface{} {
+    return s.head
+}
+
+// HeadOption returns the optional first element.
+func (s *Stream) HeadOption() *Option {
+    if s.isCons {
+        return Some(s.head)
+    } else {
+        return None()
+    }
+}
+
+// Tail returns the stream of elements except the first element and computes it if it isn't
+// computed. If stream is empty, Tail returns an empty stream.
+func (s *Stream) Tail() *Stream {
+    if s.isCons {
+        return StreamOrElse(s.tail.Force(), EmptyStream())
+    } else {
+        return s
+    }
+}
+
+// TailOption returns the optional stream of elements except the first element.
+func (s *Stream) TailOption() *Option {
+    if s.isCons {
+        return Some(s.Tail())
+    } else {
+        return None()
+    }
+}
+
+// streamStringLimit is the maximal number of elements that String shows, because a cyclic stream,
+// for example from Repeat, never ends.
+const streamStringLimit = 100
+
+func (s *Stream) String() string {
+    str := "Stream["
+    isFirst := true
+    s2 := s
+    for i := 0; s2.isCons; i++ {
+        if !isFirst {
+            str += " "
+        }
+        if i >= streamStringLimit {
+            str += "..."
+            break
+        }
+        str += fmt.Sprintf("%v", s2.head)
+        isFirst = false
+        if !s2.tail.IsForced() {
+            str += " ..."
+            break
+        }
+        s2 = s2.Tail()
+    }
+    str += "]"
+    return str
+}
+
+// Take returns the stream of the first n elements. Take doesn't compute the tails.
+func (s *Stream) Take(n int) *Stream {
+    if n > 0 && s.isCons {
+        return ConsStream(s.head, func() *Stream {
+                return s.Tail().Take(n - 1)
+        })
+    } else {
+        return EmptyStream()
+    }
+}
+
+// TakeWhile returns the stream of the first elements that satisfy f.
+func (s *Stream) TakeWhile(f func(interface{}) bool) *Stream {
+    if s.isCons && f(s.head) {
+        return ConsStream(s.head, func() *Stream {
+                return s.Tail().TakeWhile(f)
+        })
+    } else {
+        return EmptyStream()
+    }
+}
+
+// Drop returns the stream without the first n elements. Drop computes only n tails.
+func (s *Stream) Drop(n int) *Stream {
+    s2 := s
+    for i := 0; i < n && s2.isCons; i++ {
+        s2 = s2.Tail()
+    }
+    return s2
+}
+
+// Filter returns the stream of elements that satisfy f. Filter computes the tails to the first
+// element that satisfies f.
+func (s *Stream) Filter(f func(interface{}) bool) *Stream {
+    for s2 := s; s2.isCons; s2 = s2.Tail() {
+        if f(s2.head) {
+            s3 := s2
+            return ConsStream(s3.head, func() *Stream {
+                    return s3.Tail().Filter(f)
+            })
+        }
+    }
+    return EmptyStream()
+}
+
+// concat concatenates the stream and the stream that is computed by f. F is called when the
+// elements of s are visited.
+func (s *Stream) concat(f func() *Stream) *Stream {
+    if s.isCons {
+        return ConsStream(s.head, func() *Stream {
+                return s.Tail().concat(f)
+        })
+    } else {
+        return f()
+    }
+}
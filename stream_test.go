@@ -0,0 +1,208 @@
+/*
+ * Copyright (c) 2020 Łukasz Szpakowski
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in
+ * all copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+ * THE SOFTWARE.
+ */
+
+package gofun_test
+import (
+    "reflect"
+    "strings"
+    "testing"
+    . "gofun"
+)
+
+func TestIterateFunctionCreatesInfiniteStream(t *testing.T) {
+    xs := ToList(Iterate(inc, 1).Take(5))
+    if !reflect.DeepEqual(xs, ToList(InterfaceSlice([]interface{} { 1, 2, 3, 4, 5 }))) {
+        t.Errorf("Iterate function result is %v; want %v", xs, ToList(InterfaceSlice([]interface{} { 1, 2, 3, 4, 5 })))
+    }
+}
+
+func TestRepeatFunctionCreatesInfiniteStream(t *testing.T) {
+    xs := ToSlice(Repeat("a").Take(3))
+    if !reflect.DeepEqual(xs, InterfaceSlice([]interface{} { "a", "a", "a" })) {
+        t.Errorf("Repeat function result is %v; want %v", xs, InterfaceSlice([]interface{} { "a", "a", "a" }))
+    }
+}
+
+func TestCycleFunctionCreatesInfiniteStream(t *testing.T) {
+    xs := ToSlice(Cycle(InterfaceSlice([]interface{} { 1, 2, 3 })).Take(7))
+    if !reflect.DeepEqual(xs, InterfaceSlice([]interface{} { 1, 2, 3, 1, 2, 3, 1 })) {
+        t.Errorf("Cycle function result is %v; want %v", xs, InterfaceSlice([]interface{} { 1, 2, 3, 1, 2, 3, 1 }))
+    }
+}
+
+func TestCycleFunctionCreatesEmptyStreamForEmptySlice(t *testing.T) {
+    s := Cycle(InterfaceSlice([]interface{} {}))
+    if s.IsEmpty() != true {
+        t.Errorf("Stream.IsEmpty method result is %v; want %v", s.IsEmpty(), true)
+    }
+}
+
+func TestUnfoldFunctionCreatesStream(t *testing.T) {
+    xs := ToSlice(Unfold(func(x interface{}) *Option {
+            n := IntOrElse(x, 0)
+            if n < 4 {
+                return Some(NewPair(n * n, n + 1))
+            } else {
+                return None()
+            }
+    }, 0))
+    if !reflect.DeepEqual(xs, InterfaceSlice([]interface{} { 0, 1, 4, 9 })) {
+        t.Errorf("Unfold function result is %v; want %v", xs, InterfaceSlice([]interface{} { 0, 1, 4, 9 }))
+    }
+}
+
+func TestStreamTailMethodComputesTailOnce(t *testing.T) {
+    count := 0
+    s := ConsStream(1, func() *Stream {
+            count++
+            return StreamUnit(2).(*Stream)
+    })
+    if count != 0 {
+        t.Errorf("number of calls is %v; want %v", count, 0)
+    }
+    s.Tail()
+    s.Tail()
+    if count != 1 {
+        t.Errorf("number of calls is %v; want %v", count, 1)
+    }
+}
+
+func TestStreamTakeWhileMethodTakesElements(t *testing.T) {
+    xs := ToSlice(Iterate(inc, 1).TakeWhile(func(x interface{}) bool {
+            return IntOrElse(x, 0) < 4
+    }))
+    if !reflect.DeepEqual(xs, InterfaceSlice([]interface{} { 1, 2, 3 })) {
+        t.Errorf("Stream.TakeWhile method result is %v; want %v", xs, InterfaceSlice([]interface{} { 1, 2, 3 }))
+    }
+}
+
+func TestStreamDropMethodDropsElements(t *testing.T) {
+    xs := ToSlice(Iterate(inc, 1).Drop(3).Take(2))
+    if !reflect.DeepEqual(xs, InterfaceSlice([]interface{} { 4, 5 })) {
+        t.Errorf("Stream.Drop method result is %v; want %v", xs, InterfaceSlice([]interface{} { 4, 5 }))
+    }
+}
+
+func TestStreamFilterMethodFiltersInfiniteStream(t *testing.T) {
+    xs := ToSlice(Iterate(inc, 1).Filter(func(x interface{}) bool {
+            return IntOrElse(x, 0) % 3 == 0
+    }).Take(3))
+    if !reflect.DeepEqual(xs, InterfaceSlice([]interface{} { 3, 6, 9 })) {
+        t.Errorf("Stream.Filter method result is %v; want %v", xs, InterfaceSlice([]interface{} { 3, 6, 9 }))
+    }
+}
+
+func TestStreamMapMethodMapsInfiniteStream(t *testing.T) {
+    xs := ToSlice(StreamOrElse(Iterate(inc, 1).Map(func(x interface{}) interface{} {
+            return IntOrElse(x, 0) * 2
+    }), EmptyStream()).Take(3))
+    if !reflect.DeepEqual(xs, InterfaceSlice([]interface{} { 2, 4, 6 })) {
+        t.Errorf("Stream.Map method result is %v; want %v", xs, InterfaceSlice([]interface{} { 2, 4, 6 }))
+    }
+}
+
+func TestStreamBindMethodBindsInfiniteStream(t *testing.T) {
+    xs := ToSlice(StreamOrElse(Iterate(inc, 1).Bind(func(x interface{}) Monad {
+            if IntOrElse(x, 0) % 2 == 0 {
+                return EmptyStream()
+            } else {
+                return ToStream(InterfaceSlice([]interface{} { x, x }))
+            }
+    }), EmptyStream()).Take(5))
+    if !reflect.DeepEqual(xs, InterfaceSlice([]interface{} { 1, 1, 3, 3, 5 })) {
+        t.Errorf("Stream.Bind method result is %v; want %v", xs, InterfaceSlice([]interface{} { 1, 1, 3, 3, 5 }))
+    }
+}
+
+func TestStreamZipMethodZipsInfiniteStreams(t *testing.T) {
+    xs := ToSlice(StreamOrElse(Iterate(inc, 1).Zip(Cycle(InterfaceSlice([]interface{} { "a", "b" })), nil), EmptyStream()).Take(3))
+    ys := InterfaceSlice([]interface{} { NewPair(1, "a"), NewPair(2, "b"), NewPair(3, "a") })
+    if !reflect.DeepEqual(xs, ys) {
+        t.Errorf("Stream.Zip method result is %v; want %v", xs, ys)
+    }
+}
+
+func TestStreamUnzipMethodUnzipsInfiniteStream(t *testing.T) {
+    xs, ys := Repeat(NewPair(1, "a")).Unzip(nil)
+    xs2 := ToSlice(StreamOrElse(xs, EmptyStream()).Take(2))
+    ys2 := ToSlice(StreamOrElse(ys, EmptyStream()).Take(2))
+    if !reflect.DeepEqual(xs2, InterfaceSlice([]interface{} { 1, 1 })) {
+        t.Errorf("Stream.Unzip method first result is %v; want %v", xs2, InterfaceSlice([]interface{} { 1, 1 }))
+    }
+    if !reflect.DeepEqual(ys2, InterfaceSlice([]interface{} { "a", "a" })) {
+        t.Errorf("Stream.Unzip method second result is %v; want %v", ys2, InterfaceSlice([]interface{} { "a", "a" }))
+    }
+}
+
+func TestFindFunctionFindsElementInInfiniteStream(t *testing.T) {
+    o := Find(func(x interface{}) bool {
+            return IntOrElse(x, 0) > 100
+    }, Iterate(inc, 1))
+    if !reflect.DeepEqual(o, Some(101)) {
+        t.Errorf("Find function result is %v; want %v", o, Some(101))
+    }
+}
+
+func TestAnyFunctionTerminatesForInfiniteStream(t *testing.T) {
+    b := Any(func(x interface{}) bool {
+            return IntOrElse(x, 0) == 10
+    }, Iterate(inc, 1))
+    if b != true {
+        t.Errorf("Any function result is %v; want %v", b, true)
+    }
+}
+
+func TestStreamFoldRightMethodFoldsStream(t *testing.T) {
+    x := ToStream(InterfaceSlice([]interface{} { 1, 2, 3 })).FoldRight(func(x, y interface{}) interface{} {
+            return Cons(x, ListOrElse(y, Nil()))
+    }, Nil())
+    if !reflect.DeepEqual(x, Cons(1, Cons(2, Cons(3, Nil())))) {
+        t.Errorf("Stream.FoldRight method result is %v; want %v", x, Cons(1, Cons(2, Cons(3, Nil()))))
+    }
+}
+
+func TestStreamStringMethodShowsComputedElements(t *testing.T) {
+    s := Iterate(inc, 1)
+    s.Drop(2)
+    str := s.String()
+    if str != "Stream[1 2 3 ...]" {
+        t.Errorf("Stream.String method result is %v; want %v", str, "Stream[1 2 3 ...]")
+    }
+}
+
+func TestStreamStringMethodShowsLimitedElementsOfRepeat(t *testing.T) {
+    str := Repeat(1).String()
+    want := "Stream[" + strings.Repeat("1 ", 100) + "...]"
+    if str != want {
+        t.Errorf("Stream.String method result is %v; want %v", str, want)
+    }
+}
+
+func TestStreamStringMethodShowsLimitedElementsOfForcedCycle(t *testing.T) {
+    s := Cycle(InterfaceSlice([]interface{} { 1, 2 }))
+    s.Drop(10)
+    str := s.String()
+    want := "Stream[" + strings.Repeat("1 2 ", 50) + "...]"
+    if str != want {
+        t.Errorf("Stream.String method result is %v; want %v", str, want)
+    }
+}
@@ -0,0 +1,145 @@
+/*
+ * Copyright (c) 2020 Łukasz Szpakowski
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in
+ * all copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+ * THE SOFTWARE.
+ */
+
+package gofun
+
+// Traversable is the interface for traversing.
+type Traversable interface {
+    // Traverse maps the elements to Monads by f and returns Monad with Traversable of the results
+    // of these Monads. Unit must be the unit function for specified monad.
+    Traverse(f func(interface{}) Monad, unit func(interface{}) Monad) Monad
+    // Sequence is similar to Traverse but the elements must be Monads.
+    Sequence(unit func(interface{}) Monad) Monad
+}
+
+// TraversableOrElse returns x if x is Traversable, otherwise y.
+func TraversableOrElse(x interface{}, y Traversable) Traversable {
+    z, isOk := x.(Traversable)
+    if isOk {
+        return z
+    } else {
+        return y
+    }
+}
+
+// traverseFoldable maps the elements to Monads by f and returns Monad with the reversed list of
+// the results of these Monads. The list is immutable, so it can be shared by many results of
+// the monad.
+func traverseFoldable(f func(interface{}) Monad, xs Foldable, unit func(interface{}) Monad) Monad {
+    return FoldLeftM(func(x, y interface{}) Monad {
+            return f(y).Bind(func(z interface{}) Monad {
+                    return unit(Cons(z, ListOrElse(x, Nil())))
+            })
+    }, Nil(), xs, unit)
+}
+
+func monadOrUnit(unit func(interface{}) Monad) func(interface{}) Monad {
+    return func(x interface{}) Monad {
+        return MonadOrElse(x, unit(x))
+    }
+}
+
+func (xs *List) Traverse(f func(interface{}) Monad, unit func(interface{}) Monad) Monad {
+    return traverseFoldable(f, xs, unit).Bind(func(x interface{}) Monad {
+            var ys *List = Nil()
+            for l := ListOrElse(x, Nil()); l.IsCons(); l = l.Tail() {
+                ys = Cons(l.Head(), ys)
+            }
+            return unit(ys)
+    })
+}
+
+func (xs *List) Sequence(unit func(interface{}) Monad) Monad {
+    return xs.Traverse(monadOrUnit(unit), unit)
+}
+
+func (xs InterfaceSlice) Traverse(f func(interface{}) Monad, unit func(interface{}) Monad) Monad {
+    return traverseFoldable(f, xs, unit).Bind(func(x interface{}) Monad {
+            ys := make([]interface{}, len(xs))
+            i := len(ys) - 1
+            for l := ListOrElse(x, Nil()); l.IsCons() && i >= 0; l = l.Tail() {
+                ys[i] = l.Head()
+                i--
+            }
+            return unit(InterfaceSlice(ys))
+    })
+}
+
+func (xs InterfaceSlice) Sequence(unit func(interface{}) Monad) Monad {
+    return xs.Traverse(monadOrUnit(unit), unit)
+}
+
+func (xs *Option) Traverse(f func(interface{}) Monad, unit func(interface{}) Monad) Monad {
+    if xs.IsSome() {
+        return f(xs.Get()).Bind(func(x interface{}) Monad {
+                return unit(Some(x))
+        })
+    } else {
+        return unit(None())
+    }
+}
+
+func (xs *Option) Sequence(unit func(interface{}) Monad) Monad {
+    return xs.Traverse(monadOrUnit(unit), unit)
+}
+
+func (xs *Either) Traverse(f func(interface{}) Monad, unit func(interface{}) Monad) Monad {
+    if xs.IsRight() {
+        return f(xs.GetRight()).Bind(func(x interface{}) Monad {
+                return unit(Right(x))
+        })
+    } else {
+        return unit(xs)
+    }
+}
+
+func (xs *Either) Sequence(unit func(interface{}) Monad) Monad {
+    return xs.Traverse(monadOrUnit(unit), unit)
+}
+
+// Traverse maps the pairs to Monads by f and returns Monad with InterfacePairMap of the pairs from
+// these Monads. The results of these Monads that aren't pairs are omitted. The keys of the pairs
+// are compared by the Equal function.
+func (xs InterfacePairMap) Traverse(f func(interface{}) Monad, unit func(interface{}) Monad) Monad {
+    return traverseFoldable(f, xs, unit).Bind(func(x interface{}) Monad {
+            ys := make(map[interface{}]interface{}, len(xs))
+            ks := newEqualKeys(nil)
+            for l := ListOrElse(x, Nil()); l.IsCons(); l = l.Tail() {
+                p, isOk := l.Head().(*Pair)
+                if isOk {
+                    ys[ks.key(p.First)] = p.Second
+                }
+            }
+            return unit(InterfacePairMap(ys))
+    })
+}
+
+// Sequence is similar to Traverse but the values of pairs must be Monads. Sequence returns Monad
+// with InterfacePairMap of the keys and the results of these Monads.
+func (xs InterfacePairMap) Sequence(unit func(interface{}) Monad) Monad {
+    return xs.Traverse(func(x interface{}) Monad {
+            p := PairOrElse(x, NewPair(nil, nil))
+            return MonadOrElse(p.Second, unit(p.Second)).Bind(func(y interface{}) Monad {
+                    return unit(NewPair(p.First, y))
+            })
+    }, unit)
+}
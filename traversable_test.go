@@ -0,0 +1,130 @@
+/*
+ * Copyright (c) 2020 Łukasz Szpakowski
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in
+ * all copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+ * THE SOFTWARE.
+ */
+
+package gofun_test
+import (
+    "reflect"
+    "testing"
+    . "gofun"
+)
+
+func TestTraverseMethodTraversesListForEither(t *testing.T) {
+    m := Cons(1, Cons(2, Cons(3, Nil()))).Traverse(func(x interface{}) Monad {
+            return Right(IntOrElse(x, 0) * 2)
+    }, EitherUnit)
+    if !reflect.DeepEqual(m, Right(Cons(2, Cons(4, Cons(6, Nil()))))) {
+        t.Errorf("Traverse method result is %v; want %v", m, Right(Cons(2, Cons(4, Cons(6, Nil())))))
+    }
+}
+
+func TestTraverseMethodStopsAtFirstLeft(t *testing.T) {
+    n := 0
+    m := Cons(1, Cons(2, Cons(3, Nil()))).Traverse(func(x interface{}) Monad {
+            n++
+            if IntOrElse(x, 0) == 2 {
+                return Left("error")
+            } else {
+                return Right(x)
+            }
+    }, EitherUnit)
+    if !reflect.DeepEqual(m, Left("error")) {
+        t.Errorf("Traverse method result is %v; want %v", m, Left("error"))
+    }
+    if n != 2 {
+        t.Errorf("number of f calls is %v; want %v", n, 2)
+    }
+}
+
+func TestSequenceMethodSequencesListOfEithers(t *testing.T) {
+    m := Cons(Right(1), Cons(Right(2), Nil())).Sequence(EitherUnit)
+    if !reflect.DeepEqual(m, Right(Cons(1, Cons(2, Nil())))) {
+        t.Errorf("Sequence method result is %v; want %v", m, Right(Cons(1, Cons(2, Nil()))))
+    }
+}
+
+func TestSequenceMethodSequencesInterfaceSliceOfOptions(t *testing.T) {
+    m := InterfaceSlice([]interface{} { Some(1), None(), Some(3) }).Sequence(OptionUnit)
+    if !reflect.DeepEqual(m, None()) {
+        t.Errorf("Sequence method result is %v; want %v", m, None())
+    }
+}
+
+func TestTraverseMethodTraversesInterfaceSliceForList(t *testing.T) {
+    m := InterfaceSlice([]interface{} { 1, 2 }).Traverse(func(x interface{}) Monad {
+            return Cons(x, Cons(IntOrElse(x, 0) * 10, Nil()))
+    }, ListUnit)
+    xs := Cons(InterfaceSlice([]interface{} { 1, 2 }), Cons(InterfaceSlice([]interface{} { 1, 20 }), Cons(InterfaceSlice([]interface{} { 10, 2 }), Cons(InterfaceSlice([]interface{} { 10, 20 }), Nil()))))
+    if !reflect.DeepEqual(m, xs) {
+        t.Errorf("Traverse method result is %v; want %v", m, xs)
+    }
+}
+
+func TestTraverseMethodTraversesInterfaceSliceForST(t *testing.T) {
+    m := InterfaceSlice([]interface{} { 1, 2, 3 }).Traverse(func(x interface{}) Monad {
+            return GetST().Bind(func(s interface{}) Monad {
+                    return SetST(IntOrElse(s, 0) + IntOrElse(x, 0)).Map(func(y interface{}) interface{} {
+                            return s
+                    }).(Monad)
+            })
+    }, STUnit)
+    s, x := RunST(STOrElse(m, GetST()), 0)
+    if !reflect.DeepEqual(s, 6) {
+        t.Errorf("RunST function first result from Traverse method result is %v; want %v", s, 6)
+    }
+    if !reflect.DeepEqual(x, InterfaceSlice([]interface{} { 0, 1, 3 })) {
+        t.Errorf("RunST function second result from Traverse method result is %v; want %v", x, InterfaceSlice([]interface{} { 0, 1, 3 }))
+    }
+}
+
+func TestSequenceMethodSequencesSomeOfList(t *testing.T) {
+    m := Some(Cons(1, Cons(2, Nil()))).Sequence(ListUnit)
+    if !reflect.DeepEqual(m, Cons(Some(1), Cons(Some(2), Nil()))) {
+        t.Errorf("Sequence method result is %v; want %v", m, Cons(Some(1), Cons(Some(2), Nil())))
+    }
+}
+
+func TestTraverseMethodTraversesLeft(t *testing.T) {
+    m := Left("error").Traverse(func(x interface{}) Monad {
+            return Some(x)
+    }, OptionUnit)
+    if !reflect.DeepEqual(m, Some(Left("error"))) {
+        t.Errorf("Traverse method result is %v; want %v", m, Some(Left("error")))
+    }
+}
+
+func TestSequenceMethodSequencesInterfacePairMapOfRights(t *testing.T) {
+    m := InterfacePairMap(map[interface{}]interface{} { "a": Right(1), "b": Right(2) }).Sequence(EitherUnit)
+    if !reflect.DeepEqual(m, Right(InterfacePairMap(map[interface{}]interface{} { "a": 1, "b": 2 }))) {
+        t.Errorf("Sequence method result is %v; want %v", m, Right(InterfacePairMap(map[interface{}]interface{} { "a": 1, "b": 2 })))
+    }
+}
+
+func TestTraverseMethodMergesEqualPairKeysOfInterfacePairMap(t *testing.T) {
+    m := InterfacePairMap(map[interface{}]interface{} { "a": 1, "b": 1 }).Traverse(func(x interface{}) Monad {
+            p := PairOrElse(x, NewPair("", 0))
+            return Right(NewPair(NewPair(p.Second, 0), p.Second))
+    }, EitherUnit)
+    xs := InterfacePairMapOrElse(EitherOrElse(m, Left(nil)).GetRight(), nil)
+    if len(xs) != 1 {
+        t.Errorf("length of Traverse method result is %v; want %v", len(xs), 1)
+    }
+}
@@ -0,0 +1,314 @@
+/*
+ * Copyright (c) 2020 Łukasz Szpakowski
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in
+ * all copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+ * THE SOFTWARE.
+ */
+
+package gofun
+
+// treeNode is a node of a weight-balanced tree. The nil pointer is an empty tree. The algorithms
+// on the trees are based on the join function that joins two trees and a key.
+type treeNode struct {
+    key interface{}
+    value interface{}
+    size int
+    left *treeNode
+    right *treeNode
+}
+
+func newTreeNode(l *treeNode, k, v interface{}, r *treeNode) *treeNode {
+    return &treeNode { key: k, value: v, size: treeSize(l) + treeSize(r) + 1, left: l, right: r }
+}
+
+func treeSize(t *treeNode) int {
+    if t != nil {
+        return t.size
+    } else {
+        return 0
+    }
+}
+
+func treeWeight(t *treeNode) int {
+    return treeSize(t) + 1
+}
+
+// treeIsBalanced returns true if two weights are balanced for alpha equal to 2/7.
+func treeIsBalanced(w, w2 int) bool {
+    return 5 * w >= 2 * w2 && 5 * w2 >= 2 * w
+}
+
+func treeRotateLeft(t *treeNode) *treeNode {
+    r := t.right
+    return newTreeNode(newTreeNode(t.left, t.key, t.value, r.left), r.key, r.value, r.right)
+}
+
+func treeRotateRight(t *treeNode) *treeNode {
+    l := t.left
+    return newTreeNode(l.left, l.key, l.value, newTreeNode(l.right, t.key, t.value, t.right))
+}
+
+func treeJoinRight(l *treeNode, k, v interface{}, r *treeNode) *treeNode {
+    if treeIsBalanced(treeWeight(l), treeWeight(r)) {
+        return newTreeNode(l, k, v, r)
+    }
+    t := treeJoinRight(l.right, k, v, r)
+    wl := treeWeight(l.left)
+    if treeIsBalanced(wl, treeWeight(t)) {
+        return newTreeNode(l.left, l.key, l.value, t)
+    } else if treeIsBalanced(wl, treeWeight(t.left)) && treeIsBalanced(wl + treeWeight(t.left), treeWeight(t.right)) {
+        return treeRotateLeft(newTreeNode(l.left, l.key, l.value, t))
+    } else {
+        return treeRotateLeft(newTreeNode(l.left, l.key, l.value, treeRotateRight(t)))
+    }
+}
+
+func treeJoinLeft(l *treeNode, k, v interface{}, r *treeNode) *treeNode {
+    if treeIsBalanced(treeWeight(l), treeWeight(r)) {
+        return newTreeNode(l, k, v, r)
+    }
+    t := treeJoinLeft(l, k, v, r.left)
+    wr := treeWeight(r.right)
+    if treeIsBalanced(treeWeight(t), wr) {
+        return newTreeNode(t, r.key, r.value, r.right)
+    } else if treeIsBalanced(treeWeight(t.right), wr) && treeIsBalanced(treeWeight(t.left), treeWeight(t.right) + wr) {
+        return treeRotateRight(newTreeNode(t, r.key, r.value, r.right))
+    } else {
+        return treeRotateRight(newTreeNode(treeRotateLeft(t), r.key, r.value, r.right))
+    }
+}
+
+// treeJoin joins l, the key and r. The keys of l must be less than the key and the keys of r
+// must be greater than the key.
+func treeJoin(l *treeNode, k, v interface{}, r *treeNode) *treeNode {
+    wl := treeWeight(l)
+    wr := treeWeight(r)
+    if treeIsBalanced(wl, wr) {
+        return newTreeNode(l, k, v, r)
+    } else if wl > wr {
+        return treeJoinRight(l, k, v, r)
+    } else {
+        return treeJoinLeft(l, k, v, r)
+    }
+}
+
+func treeSplitLast(t *treeNode) (*treeNode, interface{}, interface{}) {
+    if t.right == nil {
+        return t.left, t.key, t.value
+    }
+    r, k, v := treeSplitLast(t.right)
+    return treeJoin(t.left, t.key, t.value, r), k, v
+}
+
+// treeJoin2 joins two trees. The keys of l must be less than the keys of r.
+func treeJoin2(l, r *treeNode) *treeNode {
+    if l == nil {
+        return r
+    }
+    l2, k, v := treeSplitLast(l)
+    return treeJoin(l2, k, v, r)
+}
+
+// treeSplit splits the tree into the tree of the less keys, the node of the key or nil, and the
+// tree of the greater keys.
+func treeSplit(t *treeNode, k interface{}, cmp func(interface{}, interface{}) Ordering) (*treeNode, *treeNode, *treeNode) {
+    if t == nil {
+        return nil, nil, nil
+    }
+    switch cmp(k, t.key) {
+    case LT:
+        l, node, r := treeSplit(t.left, k, cmp)
+        return l, node, treeJoin(r, t.key, t.value, t.right)
+    case GT:
+        l, node, r := treeSplit(t.right, k, cmp)
+        return treeJoin(t.left, t.key, t.value, l), node, r
+    default:
+        return t.left, t, t.right
+    }
+}
+
+func treeLookup(t *treeNode, k interface{}, cmp func(interface{}, interface{}) Ordering) *treeNode {
+    for t != nil {
+        switch cmp(k, t.key) {
+        case LT:
+            t = t.left
+        case GT:
+            t = t.right
+        default:
+            return t
+        }
+    }
+    return nil
+}
+
+func treeInsert(t *treeNode, k, v interface{}, cmp func(interface{}, interface{}) Ordering) *treeNode {
+    if t == nil {
+        return newTreeNode(nil, k, v, nil)
+    }
+    switch cmp(k, t.key) {
+    case LT:
+        return treeJoin(treeInsert(t.left, k, v, cmp), t.key, t.value, t.right)
+    case GT:
+        return treeJoin(t.left, t.key, t.value, treeInsert(t.right, k, v, cmp))
+    default:
+        return newTreeNode(t.left, k, v, t.right)
+    }
+}
+
+func treeDelete(t *treeNode, k interface{}, cmp func(interface{}, interface{}) Ordering) (*treeNode, bool) {
+    if t == nil {
+        return nil, false
+    }
+    switch cmp(k, t.key) {
+    case LT:
+        l, isDeleted := treeDelete(t.left, k, cmp)
+        if !isDeleted {
+            return t, false
+        }
+        return treeJoin(l, t.key, t.value, t.right), true
+    case GT:
+        r, isDeleted := treeDelete(t.right, k, cmp)
+        if !isDeleted {
+            return t, false
+        }
+        return treeJoin(t.left, t.key, t.value, r), true
+    default:
+        return treeJoin2(t.left, t.right), true
+    }
+}
+
+// treeUnion returns the union of two trees. If both trees contain the key, the union contains
+// the node from t.
+func treeUnion(t, t2 *treeNode, cmp func(interface{}, interface{}) Ordering) *treeNode {
+    if t == nil {
+        return t2
+    } else if t2 == nil {
+        return t
+    }
+    l, node, r := treeSplit(t, t2.key, cmp)
+    if node == nil {
+        node = t2
+    }
+    return treeJoin(treeUnion(l, t2.left, cmp), node.key, node.value, treeUnion(r, t2.right, cmp))
+}
+
+// treeIntersection returns the intersection of two trees with the nodes from t.
+func treeIntersection(t, t2 *treeNode, cmp func(interface{}, interface{}) Ordering) *treeNode {
+    if t == nil || t2 == nil {
+        return nil
+    }
+    l, node, r := treeSplit(t, t2.key, cmp)
+    l2 := treeIntersection(l, t2.left, cmp)
+    r2 := treeIntersection(r, t2.right, cmp)
+    if node != nil {
+        return treeJoin(l2, node.key, node.value, r2)
+    } else {
+        return treeJoin2(l2, r2)
+    }
+}
+
+// treeDifference returns the tree of the nodes from t which keys aren't in t2.
+func treeDifference(t, t2 *treeNode, cmp func(interface{}, interface{}) Ordering) *treeNode {
+    if t == nil || t2 == nil {
+        return t
+    }
+    l, _, r := treeSplit(t, t2.key, cmp)
+    return treeJoin2(treeDifference(l, t2.left, cmp), treeDifference(r, t2.right, cmp))
+}
+
+// treeRange returns the tree of the nodes which keys are greater than or equal to from and less
+// than to.
+func treeRange(t *treeNode, from, to interface{}, cmp func(interface{}, interface{}) Ordering) *treeNode {
+    _, node, r := treeSplit(t, from, cmp)
+    if node != nil {
+        r = treeJoin(nil, node.key, node.value, r)
+    }
+    l, _, _ := treeSplit(r, to, cmp)
+    return l
+}
+
+func treeMin(t *treeNode) *treeNode {
+    if t == nil {
+        return nil
+    }
+    for t.left != nil {
+        t = t.left
+    }
+    return t
+}
+
+func treeMax(t *treeNode) *treeNode {
+    if t == nil {
+        return nil
+    }
+    for t.right != nil {
+        t = t.right
+    }
+    return t
+}
+
+// treeFloor returns the node of the greatest key that is less than or equal to k or nil.
+func treeFloor(t *treeNode, k interface{}, cmp func(interface{}, interface{}) Ordering) *treeNode {
+    var node *treeNode = nil
+    for t != nil {
+        switch cmp(k, t.key) {
+        case LT:
+            t = t.left
+        case GT:
+            node = t
+            t = t.right
+        default:
+            return t
+        }
+    }
+    return node
+}
+
+// treeCeiling returns the node of the least key that is greater than or equal to k or nil.
+func treeCeiling(t *treeNode, k interface{}, cmp func(interface{}, interface{}) Ordering) *treeNode {
+    var node *treeNode = nil
+    for t != nil {
+        switch cmp(k, t.key) {
+        case LT:
+            node = t
+            t = t.left
+        case GT:
+            t = t.right
+        default:
+            return t
+        }
+    }
+    return node
+}
+
+// treeEach calls f for the nodes in order while f returns true. If f returns false, treeEach
+// also returns false.
+func treeEach(t *treeNode, f func(*treeNode) bool) bool {
+    if t == nil {
+        return true
+    }
+    return treeEach(t.left, f) && f(t) && treeEach(t.right, f)
+}
+
+// treeEachReverse is similar to treeEach but calls f in reverse order.
+func treeEachReverse(t *treeNode, f func(*treeNode) bool) bool {
+    if t == nil {
+        return true
+    }
+    return treeEachReverse(t.right, f) && f(t) && treeEachReverse(t.left, f)
+}
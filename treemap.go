@@ -0,0 +1,183 @@
+/*
+ * Copyright (c) 2020 Łukasz Szpakowski
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in
+ * all copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+ * THE SOFTWARE.
+ */
+
+package gofun
+import "fmt"
+
+// TreeMap represents persistent ordered maps of pairs. TreeMap is a weight-balanced tree where the
+// keys are ordered by a comparator. Assoc, Dissoc, Lookup, Split and Join take O(log n) time.
+// Union, Intersection and Difference take O(m log(n / m + 1)) time where m is the size of the
+// smaller map. The operations on two maps assume that both maps have the same comparator.
+type TreeMap struct {
+    root *treeNode
+    cmp func(interface{}, interface{}) Ordering
+}
+
+// TreeMapOrElse returns x if x is TreeMap pointer, otherwise y.
+func TreeMapOrElse(x interface{}, y *TreeMap) *TreeMap {
+    return OrElse(x, y)
+}
+
+// NewTreeMap creates an empty TreeMap with the comparator. If cmp is nil, TreeMap uses the
+// Compare function.
+func NewTreeMap(cmp func(interface{}, interface{}) Ordering) *TreeMap {
+    if cmp == nil {
+        cmp = Compare
+    }
+    return &TreeMap { root: nil, cmp: cmp }
+}
+
+// ToTreeMap creates a TreeMap with the comparator from the pairs of xs. The elements that aren't
+// pairs are skipped.
+func ToTreeMap(cmp func(interface{}, interface{}) Ordering, xs Foldable) *TreeMap {
+    return TreeMapOrElse(xs.FoldLeft(func(x, y interface{}) interface{} {
+            m := TreeMapOrElse(x, nil)
+            p, isOk := y.(*Pair)
+            if isOk {
+                return m.Assoc(p.First, p.Second)
+            } else {
+                return m
+            }
+    }, NewTreeMap(cmp)), NewTreeMap(cmp))
+}
+
+func (m *TreeMap) withRoot(root *treeNode) *TreeMap {
+    return &TreeMap { root: root, cmp: m.cmp }
+}
+
+func treeNodePair(node *treeNode) *Option {
+    if node != nil {
+        return Some(NewPair(node.key, node.value))
+    } else {
+        return None()
+    }
+}
+
+// Len returns the number of pairs.
+func (m *TreeMap) Len() int {
+    return treeSize(m.root)
+}
+
+// Lookup returns the optional value for the key.
+func (m *TreeMap) Lookup(k interface{}) *Option {
+    node := treeLookup(m.root, k, m.cmp)
+    if node != nil {
+        return Some(node.value)
+    } else {
+        return None()
+    }
+}
+
+// Assoc returns the map with the value for the key.
+func (m *TreeMap) Assoc(k, v interface{}) *TreeMap {
+    return m.withRoot(treeInsert(m.root, k, v, m.cmp))
+}
+
+// Dissoc returns the map without the pair for the key.
+func (m *TreeMap) Dissoc(k interface{}) *TreeMap {
+    root, isDeleted := treeDelete(m.root, k, m.cmp)
+    if isDeleted {
+        return m.withRoot(root)
+    } else {
+        return m
+    }
+}
+
+// Min returns the optional pair of the least key.
+func (m *TreeMap) Min() *Option {
+    return treeNodePair(treeMin(m.root))
+}
+
+// Max returns the optional pair of the greatest key.
+func (m *TreeMap) Max() *Option {
+    return treeNodePair(treeMax(m.root))
+}
+
+// Floor returns the optional pair of the greatest key that is less than or equal to k.
+func (m *TreeMap) Floor(k interface{}) *Option {
+    return treeNodePair(treeFloor(m.root, k, m.cmp))
+}
+
+// Ceiling returns the optional pair of the least key that is greater than or equal to k.
+func (m *TreeMap) Ceiling(k interface{}) *Option {
+    return treeNodePair(treeCeiling(m.root, k, m.cmp))
+}
+
+// Range returns the map of the pairs which keys are greater than or equal to from and less than
+// to.
+func (m *TreeMap) Range(from, to interface{}) *TreeMap {
+    return m.withRoot(treeRange(m.root, from, to, m.cmp))
+}
+
+// Split splits the map into the map of the less keys, the optional value for the key, and the map
+// of the greater keys.
+func (m *TreeMap) Split(k interface{}) (*TreeMap, *Option, *TreeMap) {
+    l, node, r := treeSplit(m.root, k, m.cmp)
+    if node != nil {
+        return m.withRoot(l), Some(node.value), m.withRoot(r)
+    } else {
+        return m.withRoot(l), None(), m.withRoot(r)
+    }
+}
+
+// Join joins two maps where the keys of m are less than the keys of m2. If the keys overlap, Join
+// returns the union of the maps.
+func (m *TreeMap) Join(m2 *TreeMap) *TreeMap {
+    l := treeMax(m.root)
+    r := treeMin(m2.root)
+    if l == nil || r == nil || m.cmp(l.key, r.key) == LT {
+        return m.withRoot(treeJoin2(m.root, m2.root))
+    } else {
+        return m.Union(m2)
+    }
+}
+
+// Union returns the map of the pairs from both maps. If both maps contain the key, Union takes
+// the pair from m.
+func (m *TreeMap) Union(m2 *TreeMap) *TreeMap {
+    return m.withRoot(treeUnion(m.root, m2.root, m.cmp))
+}
+
+// Intersection returns the map of the pairs from m which keys are in m2.
+func (m *TreeMap) Intersection(m2 *TreeMap) *TreeMap {
+    return m.withRoot(treeIntersection(m.root, m2.root, m.cmp))
+}
+
+// Difference returns the map of the pairs from m which keys aren't in m2.
+func (m *TreeMap) Difference(m2 *TreeMap) *TreeMap {
+    return m.withRoot(treeDifference(m.root, m2.root, m.cmp))
+}
+
+func (m *TreeMap) String() string {
+    s := "TreeMap["
+    isFirst := true
+    treeEach(m.root, func(node *treeNode) bool {
+            if !isFirst {
+                s += " "
+            }
+            s += fmt.Sprintf("%v:%v", node.key, node.value)
+            isFirst = false
+            return true
+    })
+    s += "]"
+    return s
+}
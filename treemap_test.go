@@ -0,0 +1,183 @@
+/*
+ * Copyright (c) 2020 Łukasz Szpakowski
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in
+ * all copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+ * THE SOFTWARE.
+ */
+
+package gofun_test
+import (
+    "reflect"
+    "testing"
+    . "gofun"
+)
+
+func rangeTreeMap(from, to int) *TreeMap {
+    m := NewTreeMap(nil)
+    for i := from; i < to; i++ {
+        m = m.Assoc(i, i * 2)
+    }
+    return m
+}
+
+func TestTreeMapAssocMethodAssociatesValues(t *testing.T) {
+    m := NewTreeMap(nil)
+    for i := 0; i < 1000; i++ {
+        m = m.Assoc((i * 7919) % 1000, i)
+    }
+    if m.Len() != 1000 {
+        t.Errorf("TreeMap.Len method result is %v; want %v", m.Len(), 1000)
+    }
+    for i := 0; i < 1000; i++ {
+        o := m.Lookup((i * 7919) % 1000)
+        if !reflect.DeepEqual(o, Some(i)) {
+            t.Fatalf("TreeMap.Lookup method result is %v; want %v", o, Some(i))
+        }
+    }
+}
+
+func TestTreeMapFoldLeftMethodFoldsPairsInOrder(t *testing.T) {
+    m := NewTreeMap(nil).Assoc(3, "c").Assoc(1, "a").Assoc(2, "b")
+    xs := ToSlice(m)
+    ys := InterfaceSlice([]interface{} { NewPair(1, "a"), NewPair(2, "b"), NewPair(3, "c") })
+    if !reflect.DeepEqual(xs, ys) {
+        t.Errorf("TreeMap.FoldLeft method result is %v; want %v", xs, ys)
+    }
+}
+
+func TestTreeMapFoldRightMethodFoldsPairsInOrder(t *testing.T) {
+    m := NewTreeMap(nil).Assoc(3, "c").Assoc(1, "a").Assoc(2, "b")
+    l := ToList(m)
+    l2 := Cons(NewPair(1, "a"), Cons(NewPair(2, "b"), Cons(NewPair(3, "c"), Nil())))
+    if !reflect.DeepEqual(l, l2) {
+        t.Errorf("TreeMap.FoldRight method result is %v; want %v", l, l2)
+    }
+}
+
+func TestTreeMapDissocMethodRemovesPair(t *testing.T) {
+    m := rangeTreeMap(0, 100)
+    m2 := m.Dissoc(50).Dissoc(1000)
+    if m2.Len() != 99 {
+        t.Errorf("TreeMap.Len method result is %v; want %v", m2.Len(), 99)
+    }
+    if !reflect.DeepEqual(m2.Lookup(50), None()) {
+        t.Errorf("TreeMap.Lookup method result is %v; want %v", m2.Lookup(50), None())
+    }
+    if !reflect.DeepEqual(m.Lookup(50), Some(100)) {
+        t.Errorf("TreeMap.Lookup method result is %v; want %v", m.Lookup(50), Some(100))
+    }
+}
+
+func TestNewTreeMapFunctionCreatesMapWithComparator(t *testing.T) {
+    m := NewTreeMap(Reversed(Compare)).Assoc(1, "a").Assoc(3, "c").Assoc(2, "b")
+    xs := ToSlice(m)
+    ys := InterfaceSlice([]interface{} { NewPair(3, "c"), NewPair(2, "b"), NewPair(1, "a") })
+    if !reflect.DeepEqual(xs, ys) {
+        t.Errorf("TreeMap.FoldLeft method result is %v; want %v", xs, ys)
+    }
+}
+
+func TestTreeMapMinMethodAndMaxMethodReturnPairs(t *testing.T) {
+    m := rangeTreeMap(10, 20)
+    if !reflect.DeepEqual(m.Min(), Some(NewPair(10, 20))) {
+        t.Errorf("TreeMap.Min method result is %v; want %v", m.Min(), Some(NewPair(10, 20)))
+    }
+    if !reflect.DeepEqual(m.Max(), Some(NewPair(19, 38))) {
+        t.Errorf("TreeMap.Max method result is %v; want %v", m.Max(), Some(NewPair(19, 38)))
+    }
+    if !reflect.DeepEqual(NewTreeMap(nil).Min(), None()) {
+        t.Errorf("TreeMap.Min method result is %v; want %v", NewTreeMap(nil).Min(), None())
+    }
+}
+
+func TestTreeMapFloorMethodAndCeilingMethodReturnPairs(t *testing.T) {
+    m := NewTreeMap(nil).Assoc(10, "a").Assoc(20, "b").Assoc(30, "c")
+    if !reflect.DeepEqual(m.Floor(25), Some(NewPair(20, "b"))) {
+        t.Errorf("TreeMap.Floor method result is %v; want %v", m.Floor(25), Some(NewPair(20, "b")))
+    }
+    if !reflect.DeepEqual(m.Floor(20), Some(NewPair(20, "b"))) {
+        t.Errorf("TreeMap.Floor method result is %v; want %v", m.Floor(20), Some(NewPair(20, "b")))
+    }
+    if !reflect.DeepEqual(m.Floor(5), None()) {
+        t.Errorf("TreeMap.Floor method result is %v; want %v", m.Floor(5), None())
+    }
+    if !reflect.DeepEqual(m.Ceiling(25), Some(NewPair(30, "c"))) {
+        t.Errorf("TreeMap.Ceiling method result is %v; want %v", m.Ceiling(25), Some(NewPair(30, "c")))
+    }
+    if !reflect.DeepEqual(m.Ceiling(35), None()) {
+        t.Errorf("TreeMap.Ceiling method result is %v; want %v", m.Ceiling(35), None())
+    }
+}
+
+func TestTreeMapRangeMethodReturnsPairsInRange(t *testing.T) {
+    m := rangeTreeMap(0, 100).Range(10, 13)
+    ys := InterfaceSlice([]interface{} { NewPair(10, 20), NewPair(11, 22), NewPair(12, 24) })
+    if !reflect.DeepEqual(ToSlice(m), ys) {
+        t.Errorf("TreeMap.Range method result is %v; want %v", m, ys)
+    }
+}
+
+func TestTreeMapSplitMethodSplitsMap(t *testing.T) {
+    l, o, r := rangeTreeMap(0, 10).Split(5)
+    if !reflect.DeepEqual(ToSlice(l), ToSlice(rangeTreeMap(0, 5))) {
+        t.Errorf("TreeMap.Split method first result is %v; want %v", l, rangeTreeMap(0, 5))
+    }
+    if !reflect.DeepEqual(o, Some(10)) {
+        t.Errorf("TreeMap.Split method second result is %v; want %v", o, Some(10))
+    }
+    if !reflect.DeepEqual(ToSlice(r), ToSlice(rangeTreeMap(6, 10))) {
+        t.Errorf("TreeMap.Split method third result is %v; want %v", r, rangeTreeMap(6, 10))
+    }
+}
+
+func TestTreeMapJoinMethodJoinsMaps(t *testing.T) {
+    m := rangeTreeMap(0, 5).Join(rangeTreeMap(5, 100))
+    if !reflect.DeepEqual(ToSlice(m), ToSlice(rangeTreeMap(0, 100))) {
+        t.Errorf("TreeMap.Join method result is %v; want %v", m, rangeTreeMap(0, 100))
+    }
+    m2 := rangeTreeMap(0, 10).Join(rangeTreeMap(5, 15))
+    if !reflect.DeepEqual(ToSlice(m2), ToSlice(rangeTreeMap(0, 15))) {
+        t.Errorf("TreeMap.Join method result is %v; want %v", m2, rangeTreeMap(0, 15))
+    }
+}
+
+func TestTreeMapUnionMethodTakesPairsFromFirstMap(t *testing.T) {
+    m := NewTreeMap(nil).Assoc(1, "a").Assoc(2, "b").Union(NewTreeMap(nil).Assoc(2, "x").Assoc(3, "c"))
+    ys := InterfaceSlice([]interface{} { NewPair(1, "a"), NewPair(2, "b"), NewPair(3, "c") })
+    if !reflect.DeepEqual(ToSlice(m), ys) {
+        t.Errorf("TreeMap.Union method result is %v; want %v", m, ys)
+    }
+}
+
+func TestTreeMapIntersectionMethodAndDifferenceMethodCompareKeys(t *testing.T) {
+    m := rangeTreeMap(0, 10)
+    m2 := rangeTreeMap(5, 15)
+    if !reflect.DeepEqual(ToSlice(m.Intersection(m2)), ToSlice(rangeTreeMap(5, 10))) {
+        t.Errorf("TreeMap.Intersection method result is %v; want %v", m.Intersection(m2), rangeTreeMap(5, 10))
+    }
+    if !reflect.DeepEqual(ToSlice(m.Difference(m2)), ToSlice(rangeTreeMap(0, 5))) {
+        t.Errorf("TreeMap.Difference method result is %v; want %v", m.Difference(m2), rangeTreeMap(0, 5))
+    }
+}
+
+func TestTreeMapStringMethodReturnsStringInOrder(t *testing.T) {
+    s := NewTreeMap(nil).Assoc("b", 2).Assoc("a", 1).String()
+    if s != "TreeMap[a:1 b:2]" {
+        t.Errorf("TreeMap.String method result is %v; want %v", s, "TreeMap[a:1 b:2]")
+    }
+}
@@ -0,0 +1,163 @@
+/*
+ * Copyright (c) 2020 Łukasz Szpakowski
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in
+ * all copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+ * THE SOFTWARE.
+ */
+
+package gofun
+import "fmt"
+
+// TreeSet represents persistent ordered sets. TreeSet is a weight-balanced tree where the elements
+// are ordered by a comparator and has the same time complexity of the operations as TreeMap.
+type TreeSet struct {
+    root *treeNode
+    cmp func(interface{}, interface{}) Ordering
+}
+
+// TreeSetOrElse returns x if x is TreeSet pointer, otherwise y.
+func TreeSetOrElse(x interface{}, y *TreeSet) *TreeSet {
+    return OrElse(x, y)
+}
+
+// NewTreeSet creates an empty TreeSet with the comparator. If cmp is nil, TreeSet uses the
+// Compare function.
+func NewTreeSet(cmp func(interface{}, interface{}) Ordering) *TreeSet {
+    if cmp == nil {
+        cmp = Compare
+    }
+    return &TreeSet { root: nil, cmp: cmp }
+}
+
+// ToTreeSet creates a TreeSet with the comparator from the elements of xs.
+func ToTreeSet(cmp func(interface{}, interface{}) Ordering, xs Foldable) *TreeSet {
+    return TreeSetOrElse(xs.FoldLeft(func(x, y interface{}) interface{} {
+            return TreeSetOrElse(x, nil).Add(y)
+    }, NewTreeSet(cmp)), NewTreeSet(cmp))
+}
+
+func (s *TreeSet) withRoot(root *treeNode) *TreeSet {
+    return &TreeSet { root: root, cmp: s.cmp }
+}
+
+func treeNodeKey(node *treeNode) *Option {
+    if node != nil {
+        return Some(node.key)
+    } else {
+        return None()
+    }
+}
+
+// Len returns the number of elements.
+func (s *TreeSet) Len() int {
+    return treeSize(s.root)
+}
+
+// Contains returns true if the set contains the element, otherwise false.
+func (s *TreeSet) Contains(x interface{}) bool {
+    return treeLookup(s.root, x, s.cmp) != nil
+}
+
+// Add returns the set with the element.
+func (s *TreeSet) Add(x interface{}) *TreeSet {
+    return s.withRoot(treeInsert(s.root, x, nil, s.cmp))
+}
+
+// Remove returns the set without the element.
+func (s *TreeSet) Remove(x interface{}) *TreeSet {
+    root, isDeleted := treeDelete(s.root, x, s.cmp)
+    if isDeleted {
+        return s.withRoot(root)
+    } else {
+        return s
+    }
+}
+
+// Min returns the optional least element.
+func (s *TreeSet) Min() *Option {
+    return treeNodeKey(treeMin(s.root))
+}
+
+// Max returns the optional greatest element.
+func (s *TreeSet) Max() *Option {
+    return treeNodeKey(treeMax(s.root))
+}
+
+// Floor returns the optional greatest element that is less than or equal to x.
+func (s *TreeSet) Floor(x interface{}) *Option {
+    return treeNodeKey(treeFloor(s.root, x, s.cmp))
+}
+
+// Ceiling returns the optional least element that is greater than or equal to x.
+func (s *TreeSet) Ceiling(x interface{}) *Option {
+    return treeNodeKey(treeCeiling(s.root, x, s.cmp))
+}
+
+// Range returns the set of the elements that are greater than or equal to from and less than to.
+func (s *TreeSet) Range(from, to interface{}) *TreeSet {
+    return s.withRoot(treeRange(s.root, from, to, s.cmp))
+}
+
+// Split splits the set into the set of the less elements, true if the set contains the element,
+// and the set of the greater elements.
+func (s *TreeSet) Split(x interface{}) (*TreeSet, bool, *TreeSet) {
+    l, node, r := treeSplit(s.root, x, s.cmp)
+    return s.withRoot(l), node != nil, s.withRoot(r)
+}
+
+// Join joins two sets where the elements of s are less than the elements of s2. If the elements
+// overlap, Join returns the union of the sets.
+func (s *TreeSet) Join(s2 *TreeSet) *TreeSet {
+    l := treeMax(s.root)
+    r := treeMin(s2.root)
+    if l == nil || r == nil || s.cmp(l.key, r.key) == LT {
+        return s.withRoot(treeJoin2(s.root, s2.root))
+    } else {
+        return s.Union(s2)
+    }
+}
+
+// Union returns the set of the elements from both sets.
+func (s *TreeSet) Union(s2 *TreeSet) *TreeSet {
+    return s.withRoot(treeUnion(s.root, s2.root, s.cmp))
+}
+
+// Intersection returns the set of the elements that are in both sets.
+func (s *TreeSet) Intersection(s2 *TreeSet) *TreeSet {
+    return s.withRoot(treeIntersection(s.root, s2.root, s.cmp))
+}
+
+// Difference returns the set of the elements from s that aren't in s2.
+func (s *TreeSet) Difference(s2 *TreeSet) *TreeSet {
+    return s.withRoot(treeDifference(s.root, s2.root, s.cmp))
+}
+
+func (s *TreeSet) String() string {
+    str := "TreeSet["
+    isFirst := true
+    treeEach(s.root, func(node *treeNode) bool {
+            if !isFirst {
+                str += " "
+            }
+            str += fmt.Sprintf("%v", node.key)
+            isFirst = false
+            return true
+    })
+    str += "]"
+    return str
+}
@@ -0,0 +1,84 @@
+/*
+ * Copyright (c) 2020 Łukasz Szpakowski
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in
+ * all copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+ * THE SOFTWARE.
+ */
+
+package gofun_test
+import (
+    "reflect"
+    "testing"
+    . "gofun"
+)
+
+func TestTreeSetAddMethodAddsElements(t *testing.T) {
+    s := ToTreeSet(nil, InterfaceSlice([]interface{} { 3, 1, 2, 3, 1 }))
+    if s.Len() != 3 {
+        t.Errorf("TreeSet.Len method result is %v; want %v", s.Len(), 3)
+    }
+    if !reflect.DeepEqual(ToSlice(s), InterfaceSlice([]interface{} { 1, 2, 3 })) {
+        t.Errorf("TreeSet.Add method result is %v; want %v", s, InterfaceSlice([]interface{} { 1, 2, 3 }))
+    }
+    if s.Contains(2) != true {
+        t.Errorf("TreeSet.Contains method result is %v; want %v", s.Contains(2), true)
+    }
+    if s.Remove(2).Contains(2) != false {
+        t.Errorf("TreeSet.Contains method result is %v; want %v", s.Remove(2).Contains(2), false)
+    }
+}
+
+func TestTreeSetSetOperationsReturnSets(t *testing.T) {
+    s := ToTreeSet(nil, InterfaceSlice([]interface{} { 1, 2, 3, 4 }))
+    s2 := ToTreeSet(nil, InterfaceSlice([]interface{} { 3, 4, 5 }))
+    if !reflect.DeepEqual(ToSlice(s.Union(s2)), InterfaceSlice([]interface{} { 1, 2, 3, 4, 5 })) {
+        t.Errorf("TreeSet.Union method result is %v; want %v", s.Union(s2), InterfaceSlice([]interface{} { 1, 2, 3, 4, 5 }))
+    }
+    if !reflect.DeepEqual(ToSlice(s.Intersection(s2)), InterfaceSlice([]interface{} { 3, 4 })) {
+        t.Errorf("TreeSet.Intersection method result is %v; want %v", s.Intersection(s2), InterfaceSlice([]interface{} { 3, 4 }))
+    }
+    if !reflect.DeepEqual(ToSlice(s.Difference(s2)), InterfaceSlice([]interface{} { 1, 2 })) {
+        t.Errorf("TreeSet.Difference method result is %v; want %v", s.Difference(s2), InterfaceSlice([]interface{} { 1, 2 }))
+    }
+}
+
+func TestTreeSetSplitMethodSplitsSet(t *testing.T) {
+    l, isFound, r := ToTreeSet(nil, InterfaceSlice([]interface{} { 1, 2, 4, 5 })).Split(3)
+    if !reflect.DeepEqual(ToSlice(l), InterfaceSlice([]interface{} { 1, 2 })) {
+        t.Errorf("TreeSet.Split method first result is %v; want %v", l, InterfaceSlice([]interface{} { 1, 2 }))
+    }
+    if isFound != false {
+        t.Errorf("TreeSet.Split method second result is %v; want %v", isFound, false)
+    }
+    if !reflect.DeepEqual(ToSlice(r), InterfaceSlice([]interface{} { 4, 5 })) {
+        t.Errorf("TreeSet.Split method third result is %v; want %v", r, InterfaceSlice([]interface{} { 4, 5 }))
+    }
+}
+
+func TestTreeSetFloorMethodAndRangeMethodUseOrder(t *testing.T) {
+    s := ToTreeSet(nil, InterfaceSlice([]interface{} { "a", "c", "e", "g" }))
+    if !reflect.DeepEqual(s.Floor("d"), Some("c")) {
+        t.Errorf("TreeSet.Floor method result is %v; want %v", s.Floor("d"), Some("c"))
+    }
+    if !reflect.DeepEqual(s.Ceiling("d"), Some("e")) {
+        t.Errorf("TreeSet.Ceiling method result is %v; want %v", s.Ceiling("d"), Some("e"))
+    }
+    if !reflect.DeepEqual(ToSlice(s.Range("b", "g")), InterfaceSlice([]interface{} { "c", "e" })) {
+        t.Errorf("TreeSet.Range method result is %v; want %v", s.Range("b", "g"), InterfaceSlice([]interface{} { "c", "e" }))
+    }
+}
@@ -124,3 +124,69 @@ func (xs InterfacePairFunction) Unzip(fail Zippable) (Zippable, Zippable) {
     })
     return f, g
 }
+
+func (xs *Future) Unzip(fail Zippable) (Zippable, Zippable) {
+    unzip := func(f func(*Pair) interface{}) *Future {
+        return xs.then(func(e *Either) *Either {
+                if e.IsRight() {
+                    e2 := Cast[*Pair](e.GetRight())
+                    if e2.IsRight() {
+                        return Right(f(e2.GetRight().(*Pair)))
+                    } else {
+                        return e2
+                    }
+                } else {
+                    return e
+                }
+        })
+    }
+    ys := unzip(func(p *Pair) interface{} {
+            return p.First
+    })
+    zs := unzip(func(p *Pair) interface{} {
+            return p.Second
+    })
+    return ys, zs
+}
+
+func (xs *Stream) Unzip(fail Zippable) (Zippable, Zippable) {
+    ps := xs.Filter(func(x interface{}) bool {
+            _, isOk := x.(*Pair)
+            return isOk
+    })
+    ys := ps.Map(func(x interface{}) interface{} {
+            return x.(*Pair).First
+    })
+    zs := ps.Map(func(x interface{}) interface{} {
+            return x.(*Pair).Second
+    })
+    return StreamOrElse(ys, EmptyStream()), StreamOrElse(zs, EmptyStream())
+}
+
+func (xs *Vector) Unzip(fail Zippable) (Zippable, Zippable) {
+    ys := NewVector()
+    zs := NewVector()
+    xs.each(func(x interface{}) bool {
+            p, isOk := x.(*Pair)
+            if isOk {
+                ys = ys.Append(p.First)
+                zs = zs.Append(p.Second)
+            }
+            return true
+    })
+    return ys, zs
+}
+
+func (xs *FingerTree) Unzip(fail Zippable) (Zippable, Zippable) {
+    ys := xs.withRoot(nil)
+    zs := xs.withRoot(nil)
+    fingerEach(xs.root, func(x interface{}) bool {
+            p, isOk := x.(*Pair)
+            if isOk {
+                ys = ys.PushBack(p.First)
+                zs = zs.PushBack(p.Second)
+            }
+            return true
+    })
+    return ys, zs
+}
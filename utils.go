@@ -21,10 +21,26 @@
  */
 
 package gofun
+import (
+    "fmt"
+    "reflect"
+)
 
-// BoolOrElse returns x if x is bool, otherwise y. 
-func BoolOrElse(x interface{}, y bool) bool {
-    z, isOk := x.(bool)
+// TypeMismatchError is the error that is returned by Cast if a value doesn't have the expected type.
+type TypeMismatchError struct {
+    // Value is the value that has been cast.
+    Value interface{}
+    // Type is the expected type.
+    Type reflect.Type
+}
+
+func (e *TypeMismatchError) Error() string {
+    return fmt.Sprintf("gofun: value %v of type %T isn't %v", e.Value, e.Value, e.Type)
+}
+
+// OrElse returns x if x is T, otherwise y.
+func OrElse[T any](x interface{}, y T) T {
+    z, isOk := x.(T)
     if isOk {
         return z
     } else {
@@ -32,222 +48,137 @@ func BoolOrElse(x interface{}, y bool) bool {
     }
 }
 
-// ByteOrElse returns x if x is byte, otherwise y. 
-func ByteOrElse(x interface{}, y byte) byte {
-    z, isOk := x.(byte)
+// As returns Some with x if x is T, otherwise None.
+func As[T any](x interface{}) *Option {
+    z, isOk := x.(T)
     if isOk {
-        return z
+        return Some(z)
     } else {
-        return y
+        return None()
     }
 }
 
-// Complex64OrElse returns x if x is complex64, otherwise y. 
-func Complex64OrElse(x interface{}, y complex64) complex64 {
-    z, isOk := x.(complex64)
+// Cast returns Right with x if x is T, otherwise Left with TypeMismatchError.
+func Cast[T any](x interface{}) *Either {
+    z, isOk := x.(T)
     if isOk {
-        return z
+        return Right(z)
     } else {
-        return y
+        return Left(&TypeMismatchError { Value: x, Type: reflect.TypeOf((*T)(nil)).Elem() })
     }
 }
 
+// BoolOrElse returns x if x is bool, otherwise y. 
+func BoolOrElse(x interface{}, y bool) bool {
+    return OrElse(x, y)
+}
+
+// ByteOrElse returns x if x is byte, otherwise y. 
+func ByteOrElse(x interface{}, y byte) byte {
+    return OrElse(x, y)
+}
+
+// Complex64OrElse returns x if x is complex64, otherwise y. 
+func Complex64OrElse(x interface{}, y complex64) complex64 {
+    return OrElse(x, y)
+}
+
 // Complex128OrElse returns x if x is complex128, otherwise y. 
 func Complex128OrElse(x interface{}, y complex128) complex128 {
-    z, isOk := x.(complex128)
-    if isOk {
-        return z
-    } else {
-        return y
-    }
+    return OrElse(x, y)
 }
 
 // ErrorOrElse returns x if x is error, otherwise y. 
 func ErrorOrElse(x interface{}, y error) error {
-    z, isOk := x.(error)
-    if isOk {
-        return z
-    } else {
-        return y
-    }
+    return OrElse(x, y)
 }
 
 // Float32OrElse returns x if x is float32, otherwise y. 
 func Float32OrElse(x interface{}, y float32) float32 {
-    z, isOk := x.(float32)
-    if isOk {
-        return z
-    } else {
-        return y
-    }
+    return OrElse(x, y)
 }
 
 // Float64OrElse returns x if x is float64, otherwise y. 
 func Float64OrElse(x interface{}, y float64) float64 {
-    z, isOk := x.(float64)
-    if isOk {
-        return z
-    } else {
-        return y
-    }
+    return OrElse(x, y)
 }
 
 // IntOrElse returns x if x is int, otherwise y. 
 func IntOrElse(x interface{}, y int) int {
-    z, isOk := x.(int)
-    if isOk {
-        return z
-    } else {
-        return y
-    }
+    return OrElse(x, y)
 }
 
 // Int8OrElse returns x if x is int8, otherwise y. 
 func Int8OrElse(x interface{}, y int8) int8 {
-    z, isOk := x.(int8)
-    if isOk {
-        return z
-    } else {
-        return y
-    }
+    return OrElse(x, y)
 }
 
 // Int16OrElse returns x if x is int16, otherwise y. 
 func Int16OrElse(x interface{}, y int16) int16 {
-    z, isOk := x.(int16)
-    if isOk {
-        return z
-    } else {
-        return y
-    }
+    return OrElse(x, y)
 }
 
 // Int132OrElse returns x if x is int32, otherwise y. 
 func Int32OrElse(x interface{}, y int32) int32 {
-    z, isOk := x.(int32)
-    if isOk {
-        return z
-    } else {
-        return y
-    }
+    return OrElse(x, y)
 }
 
 // Int164OrElse returns x if x is int64, otherwise y. 
 func Int64OrElse(x interface{}, y int64) int64 {
-    z, isOk := x.(int64)
-    if isOk {
-        return z
-    } else {
-        return y
-    }
+    return OrElse(x, y)
 }
 
 // RuneOrElse returns x if x is rune, otherwise y. 
 func RuneOrElse(x interface{}, y rune) rune {
-    z, isOk := x.(rune)
-    if isOk {
-        return z
-    } else {
-        return y
-    }
+    return OrElse(x, y)
 }
 
 // StringOrElse returns x if x is string, otherwise y. 
 func StringOrElse(x interface{}, y string) string {
-    z, isOk := x.(string)
-    if isOk {
-        return z
-    } else {
-        return y
-    }
+    return OrElse(x, y)
 }
 
 // UintOrElse returns x if x is uint, otherwise y. 
 func UintOrElse(x interface{}, y uint) uint {
-    z, isOk := x.(uint)
-    if isOk {
-        return z
-    } else {
-        return y
-    }
+    return OrElse(x, y)
 }
 
 // Uint8OrElse returns x if x is uint8, otherwise y. 
 func Uint8OrElse(x interface{}, y uint8) uint8 {
-    z, isOk := x.(uint8)
-    if isOk {
-        return z
-    } else {
-        return y
-    }
+    return OrElse(x, y)
 }
 
 // Uint16OrElse returns x if x is uint16, otherwise y. 
 func Uint16OrElse(x interface{}, y uint16) uint16 {
-    z, isOk := x.(uint16)
-    if isOk {
-        return z
-    } else {
-        return y
-    }
+    return OrElse(x, y)
 }
 
 // Uint32OrElse returns x if x is uint32, otherwise y. 
 func Uint32OrElse(x interface{}, y uint32) uint32 {
-    z, isOk := x.(uint32)
-    if isOk {
-        return z
-    } else {
-        return y
-    }
+    return OrElse(x, y)
 }
 
 // Uint64OrElse returns x if x is uint64, otherwise y. 
 func Uint64OrElse(x interface{}, y uint64) uint64 {
-    z, isOk := x.(uint64)
-    if isOk {
-        return z
-    } else {
-        return y
-    }
+    return OrElse(x, y)
 }
 
 // UintptrOrElse returns x if x is uintptr, otherwise y. 
 func UintptrOrElse(x interface{}, y uintptr) uintptr {
-    z, isOk := x.(uintptr)
-    if isOk {
-        return z
-    } else {
-        return y
-    }
+    return OrElse(x, y)
 }
 
 // InterfaceSliceOrElse returns x if x is InterfaceSlice, otherwise y. 
 func InterfaceSliceOrElse(x interface{}, y InterfaceSlice) InterfaceSlice {
-    z, isOk := x.(InterfaceSlice)
-    if isOk {
-        return z
-    } else {
-        return y
-    }
+    return OrElse(x, y)
 }
 
 // InterfacePairMapOrElse returns x if x is InterfacePairMap, otherwise y. 
 func InterfacePairMapOrElse(x interface{}, y InterfacePairMap) InterfacePairMap {
-    z, isOk := x.(InterfacePairMap)
-    if isOk {
-        return z
-    } else {
-        return y
-    }
+    return OrElse(x, y)
 }
 
 // InterfacePairFunctionOrElse returns x if x is InterfacePairFunction, otherwise y. 
 func InterfacePairFunctionOrElse(x interface{}, y InterfacePairFunction) InterfacePairFunction {
-    z, isOk := x.(InterfacePairFunction)
-    if isOk {
-        return z
-    } else {
-        return y
-    }
+    return OrElse(x, y)
 }
@@ -0,0 +1,84 @@
+/*
+ * Copyright (c) 2020 Łukasz Szpakowski
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in
+ * all copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+ * THE SOFTWARE.
+ */
+
+package gofun_test
+import (
+    "reflect"
+    "testing"
+    . "gofun"
+)
+
+type testUser struct {
+    name string
+}
+
+func TestOrElseFunctionReturnsValueForSameType(t *testing.T) {
+    x := OrElse(testUser { "a" }, testUser { "b" })
+    if !reflect.DeepEqual(x, testUser { "a" }) {
+        t.Errorf("OrElse function result is %v; want %v", x, testUser { "a" })
+    }
+}
+
+func TestOrElseFunctionReturnsDefaultValueForOtherType(t *testing.T) {
+    x := OrElse(1, "b")
+    if !reflect.DeepEqual(x, "b") {
+        t.Errorf("OrElse function result is %v; want %v", x, "b")
+    }
+}
+
+func TestAsFunctionReturnsSomeForSameType(t *testing.T) {
+    o := As[int](2)
+    if !reflect.DeepEqual(o, Some(2)) {
+        t.Errorf("As function result is %v; want %v", o, Some(2))
+    }
+}
+
+func TestAsFunctionReturnsNoneForOtherType(t *testing.T) {
+    o := As[int]("a")
+    if !reflect.DeepEqual(o, None()) {
+        t.Errorf("As function result is %v; want %v", o, None())
+    }
+}
+
+func TestCastFunctionReturnsRightForInterfaceType(t *testing.T) {
+    e := Cast[Foldable](Nil())
+    if !reflect.DeepEqual(e, Right(Nil())) {
+        t.Errorf("Cast function result is %v; want %v", e, Right(Nil()))
+    }
+}
+
+func TestCastFunctionReturnsLeftWithErrorForOtherType(t *testing.T) {
+    e := Cast[testUser]("a")
+    if !e.IsLeft() {
+        t.Errorf("Cast function result is %v; want Left", e)
+    } else {
+        err, isOk := e.GetLeft().(*TypeMismatchError)
+        if !isOk {
+            t.Errorf("Cast function left value type isn't TypeMismatchError")
+        } else {
+            s := err.Error()
+            if s != "gofun: value a of type string isn't gofun_test.testUser" {
+                t.Errorf("Error method result is %q; want %q", s, "gofun: value a of type string isn't gofun_test.testUser")
+            }
+        }
+    }
+}
@@ -0,0 +1,278 @@
+/*
+ * Copyright (c) 2020 Łukasz Szpakowski
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in
+ * all copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+ * THE SOFTWARE.
+ */
+
+package gofun
+import "fmt"
+
+const (
+    vectorBits = 5
+    vectorWidth = 1 << vectorBits
+    vectorMask = vectorWidth - 1
+)
+
+// vectorNode is a node of the trie of Vector. The children of a leaf are the elements and the
+// children of other nodes are vectorNode pointers.
+type vectorNode struct {
+    children []interface{}
+}
+
+// Vector represents persistent vectors. Vector is a bit-partitioned trie with a branching factor
+// of 32 and a tail, so Get, Set and Append take O(log32 n) time and don't change the vector. A
+// sliced vector is a view of the original trie, so it shares the elements with the original
+// vector.
+type Vector struct {
+    size int
+    shift uint
+    root *vectorNode
+    tail []interface{}
+    offset int
+    length int
+}
+
+// VectorOrElse returns x if x is Vector pointer, otherwise y.
+func VectorOrElse(x interface{}, y *Vector) *Vector {
+    return OrElse(x, y)
+}
+
+// NewVector creates an empty vector.
+func NewVector() *Vector {
+    return &Vector { size: 0, shift: vectorBits, root: &vectorNode {}, tail: nil, offset: 0, length: 0 }
+}
+
+// ToVector creates a vector from the elements of xs.
+func ToVector(xs Foldable) *Vector {
+    return VectorOrElse(xs.FoldLeft(func(x, y interface{}) interface{} {
+            return VectorOrElse(x, NewVector()).Append(y)
+    }, NewVector()), NewVector())
+}
+
+// Len returns the number of elements.
+func (v *Vector) Len() int {
+    return v.length
+}
+
+func (v *Vector) tailOffset() int {
+    return v.size - len(v.tail)
+}
+
+// leaf returns the leaf or the tail that contains the element for the index of the trie.
+func (v *Vector) leaf(i int) []interface{} {
+    if i >= v.tailOffset() {
+        return v.tail
+    }
+    node := v.root
+    for level := v.shift; level > 0; level -= vectorBits {
+        node = node.children[(i >> level) & vectorMask].(*vectorNode)
+    }
+    return node.children
+}
+
+// Get returns the optional element for the index.
+func (v *Vector) Get(i int) *Option {
+    if i >= 0 && i < v.length {
+        j := v.offset + i
+        return Some(v.leaf(j)[j & vectorMask])
+    } else {
+        return None()
+    }
+}
+
+func vectorAssoc(level uint, node *vectorNode, i int, x interface{}) *vectorNode {
+    children := append([]interface{} {}, node.children...)
+    if level == 0 {
+        children[i & vectorMask] = x
+    } else {
+        j := (i >> level) & vectorMask
+        children[j] = vectorAssoc(level - vectorBits, children[j].(*vectorNode), i, x)
+    }
+    return &vectorNode { children: children }
+}
+
+// assoc sets the element for the index of the trie.
+func (v *Vector) assoc(i int, x interface{}) *Vector {
+    v2 := *v
+    if i >= v.tailOffset() {
+        v2.tail = append([]interface{} {}, v.tail...)
+        v2.tail[i & vectorMask] = x
+    } else {
+        v2.root = vectorAssoc(v.shift, v.root, i, x)
+    }
+    return &v2
+}
+
+// Set returns the vector with the element for the index. If the index is out of range, Set
+// returns the same vector.
+func (v *Vector) Set(i int, x interface{}) *Vector {
+    if i >= 0 && i < v.length {
+        return v.assoc(v.offset + i, x)
+    } else {
+        return v
+    }
+}
+
+func vectorNewPath(level uint, node *vectorNode) *vectorNode {
+    if level == 0 {
+        return node
+    } else {
+        return &vectorNode { children: []interface{} { vectorNewPath(level - vectorBits, node) } }
+    }
+}
+
+func (v *Vector) pushTail(level uint, parent *vectorNode, tailNode *vectorNode) *vectorNode {
+    i := ((v.size - 1) >> level) & vectorMask
+    children := append([]interface{} {}, parent.children...)
+    var node *vectorNode
+    if level == vectorBits {
+        node = tailNode
+    } else if i < len(children) {
+        node = v.pushTail(level - vectorBits, children[i].(*vectorNode), tailNode)
+    } else {
+        node = vectorNewPath(level - vectorBits, tailNode)
+    }
+    if i < len(children) {
+        children[i] = node
+    } else {
+        children = append(children, node)
+    }
+    return &vectorNode { children: children }
+}
+
+// conj appends the element to the trie.
+func (v *Vector) conj(x interface{}) *Vector {
+    if len(v.tail) < vectorWidth {
+        v2 := *v
+        v2.tail = append(append(make([]interface{}, 0, len(v.tail) + 1), v.tail...), x)
+        v2.size++
+        return &v2
+    } else {
+        return v.pushLeaf([]interface{} { x })
+    }
+}
+
+// pushLeaf moves the full tail to the trie and makes the leaf the new tail.
+func (v *Vector) pushLeaf(leaf []interface{}) *Vector {
+    v2 := *v
+    tailNode := &vectorNode { children: v.tail }
+    if (v.size >> vectorBits) > (1 << v.shift) {
+        v2.root = &vectorNode { children: []interface{} { v.root, vectorNewPath(v.shift, tailNode) } }
+        v2.shift += vectorBits
+    } else {
+        v2.root = v.pushTail(v.shift, v.root, tailNode)
+    }
+    v2.tail = leaf
+    v2.size += len(leaf)
+    return &v2
+}
+
+// Append returns the vector with the element at the end.
+func (v *Vector) Append(x interface{}) *Vector {
+    var v2 *Vector
+    if v.offset + v.length == v.size {
+        v2 = v.conj(x)
+    } else {
+        v2 = v.assoc(v.offset + v.length, x)
+    }
+    v2.length++
+    return v2
+}
+
+// Slice returns the vector of the elements from the index from to the index to that is excluded.
+// The indices are clamped to the range of the vector. Slice takes O(1) time because the result is
+// a view of v.
+func (v *Vector) Slice(from, to int) *Vector {
+    if from < 0 {
+        from = 0
+    } else if from > v.length {
+        from = v.length
+    }
+    if to < 0 {
+        to = 0
+    } else if to > v.length {
+        to = v.length
+    }
+    if from > to {
+        from = to
+    }
+    v2 := *v
+    v2.offset = v.offset + from
+    v2.length = to - from
+    return &v2
+}
+
+// Concat concatenates two vectors. Concat appends the elements of ys to the tail of xs until the
+// tail is full and then copies the elements of ys to the trie by whole leaves, so it takes
+// O(m + m/32 log32 n) time where m is the length of ys. If xs is a slice that doesn't end at the
+// end of the original vector, Concat first overwrites the following elements one by one.
+func (xs *Vector) Concat(ys *Vector) *Vector {
+    zs := xs
+    var leaf []interface{}
+    ys.each(func(y interface{}) bool {
+            if leaf == nil && (zs.offset + zs.length != zs.size || len(zs.tail) < vectorWidth) {
+                zs = zs.Append(y)
+            } else {
+                if leaf == nil {
+                    leaf = make([]interface{}, 0, vectorWidth)
+                }
+                leaf = append(leaf, y)
+                if len(leaf) == vectorWidth {
+                    zs = zs.pushLeaf(leaf)
+                    zs.length += len(leaf)
+                    leaf = nil
+                }
+            }
+            return true
+    })
+    if leaf != nil {
+        zs = zs.pushLeaf(leaf)
+        zs.length += len(leaf)
+    }
+    return zs
+}
+
+// each calls f for the elements while f returns true.
+func (v *Vector) each(f func(interface{}) bool) {
+    for i := 0; i < v.length; {
+        j := v.offset + i
+        leaf := v.leaf(j)
+        for k := j & vectorMask; k < len(leaf) && i < v.length; k++ {
+            if !f(leaf[k]) {
+                return
+            }
+            i++
+        }
+    }
+}
+
+func (v *Vector) String() string {
+    s := "Vector["
+    isFirst := true
+    v.each(func(x interface{}) bool {
+            if !isFirst {
+                s += " "
+            }
+            s += fmt.Sprintf("%v", x)
+            isFirst = false
+            return true
+    })
+    s += "]"
+    return s
+}
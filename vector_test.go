@@ -0,0 +1,245 @@
+/*
+ * Copyright (c) 2020 Łukasz Szpakowski
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in
+ * all copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+ * THE SOFTWARE.
+ */
+
+package gofun_test
+import (
+    "reflect"
+    "testing"
+    . "gofun"
+)
+
+func rangeVector(n int) *Vector {
+    v := NewVector()
+    for i := 0; i < n; i++ {
+        v = v.Append(i)
+    }
+    return v
+}
+
+func TestVectorAppendMethodAppendsElements(t *testing.T) {
+    v := rangeVector(100000)
+    if v.Len() != 100000 {
+        t.Errorf("Vector.Len method result is %v; want %v", v.Len(), 100000)
+    }
+    for i := 0; i < 100000; i++ {
+        o := v.Get(i)
+        if !reflect.DeepEqual(o, Some(i)) {
+            t.Fatalf("Vector.Get method result is %v; want %v", o, Some(i))
+        }
+    }
+}
+
+func TestVectorAppendMethodDoesNotChangeVector(t *testing.T) {
+    v := rangeVector(32)
+    v2 := v.Append(32)
+    v3 := v.Append(-1)
+    if !reflect.DeepEqual(ToSlice(v2.Slice(31, 33)), InterfaceSlice([]interface{} { 31, 32 })) {
+        t.Errorf("Vector.Append method result is %v; want %v", v2.Slice(31, 33), InterfaceSlice([]interface{} { 31, 32 }))
+    }
+    if !reflect.DeepEqual(ToSlice(v3.Slice(31, 33)), InterfaceSlice([]interface{} { 31, -1 })) {
+        t.Errorf("Vector.Append method result is %v; want %v", v3.Slice(31, 33), InterfaceSlice([]interface{} { 31, -1 }))
+    }
+    if v.Len() != 32 {
+        t.Errorf("Vector.Len method result is %v; want %v", v.Len(), 32)
+    }
+}
+
+func TestVectorGetMethodReturnsNoneForIndexOutOfRange(t *testing.T) {
+    v := rangeVector(3)
+    if !reflect.DeepEqual(v.Get(3), None()) {
+        t.Errorf("Vector.Get method result is %v; want %v", v.Get(3), None())
+    }
+    if !reflect.DeepEqual(v.Get(-1), None()) {
+        t.Errorf("Vector.Get method result is %v; want %v", v.Get(-1), None())
+    }
+}
+
+func TestVectorSetMethodSetsElement(t *testing.T) {
+    v := rangeVector(2000)
+    v2 := v.Set(100, "a").Set(1999, "b")
+    if !reflect.DeepEqual(v2.Get(100), Some("a")) {
+        t.Errorf("Vector.Get method result is %v; want %v", v2.Get(100), Some("a"))
+    }
+    if !reflect.DeepEqual(v2.Get(1999), Some("b")) {
+        t.Errorf("Vector.Get method result is %v; want %v", v2.Get(1999), Some("b"))
+    }
+    if !reflect.DeepEqual(v.Get(100), Some(100)) {
+        t.Errorf("Vector.Get method result is %v; want %v", v.Get(100), Some(100))
+    }
+    if !reflect.DeepEqual(v.Get(1999), Some(1999)) {
+        t.Errorf("Vector.Get method result is %v; want %v", v.Get(1999), Some(1999))
+    }
+}
+
+func TestVectorSliceMethodSlicesVector(t *testing.T) {
+    v := rangeVector(100).Slice(30, 70).Slice(5, 8)
+    if !reflect.DeepEqual(ToSlice(v), InterfaceSlice([]interface{} { 35, 36, 37 })) {
+        t.Errorf("Vector.Slice method result is %v; want %v", v, InterfaceSlice([]interface{} { 35, 36, 37 }))
+    }
+}
+
+func TestVectorSliceMethodClampsNegativeIndices(t *testing.T) {
+    v := NewVector().Append(1).Append(2).Slice(0, -1).Append(9)
+    if !reflect.DeepEqual(ToSlice(v), InterfaceSlice([]interface{} { 9 })) {
+        t.Errorf("Vector.Slice method result is %v; want %v", v, InterfaceSlice([]interface{} { 9 }))
+    }
+    v2 := rangeVector(10).Slice(-5, -2)
+    if v2.Len() != 0 {
+        t.Errorf("Vector.Len method result is %v; want %v", v2.Len(), 0)
+    }
+}
+
+func TestVectorSliceMethodClampsOutOfRangeIndices(t *testing.T) {
+    v := rangeVector(10).Slice(8, 20)
+    if !reflect.DeepEqual(ToSlice(v), InterfaceSlice([]interface{} { 8, 9 })) {
+        t.Errorf("Vector.Slice method result is %v; want %v", v, InterfaceSlice([]interface{} { 8, 9 }))
+    }
+    v2 := rangeVector(10).Slice(15, 20).Append("a")
+    if !reflect.DeepEqual(ToSlice(v2), InterfaceSlice([]interface{} { "a" })) {
+        t.Errorf("Vector.Slice method result is %v; want %v", v2, InterfaceSlice([]interface{} { "a" }))
+    }
+}
+
+func TestVectorAppendMethodAppendsElementToSlicedVector(t *testing.T) {
+    v := rangeVector(100)
+    v2 := v.Slice(10, 12).Append("a")
+    if !reflect.DeepEqual(ToSlice(v2), InterfaceSlice([]interface{} { 10, 11, "a" })) {
+        t.Errorf("Vector.Append method result is %v; want %v", v2, InterfaceSlice([]interface{} { 10, 11, "a" }))
+    }
+    if !reflect.DeepEqual(v.Get(12), Some(12)) {
+        t.Errorf("Vector.Get method result is %v; want %v", v.Get(12), Some(12))
+    }
+}
+
+func TestVectorConcatMethodConcatenatesVectors(t *testing.T) {
+    v := ToVector(InterfaceSlice([]interface{} { 1, 2 })).Concat(ToVector(InterfaceSlice([]interface{} { 3, 4 })))
+    if !reflect.DeepEqual(ToSlice(v), InterfaceSlice([]interface{} { 1, 2, 3, 4 })) {
+        t.Errorf("Vector.Concat method result is %v; want %v", v, InterfaceSlice([]interface{} { 1, 2, 3, 4 }))
+    }
+}
+
+func TestVectorConcatMethodConcatenatesLongVectors(t *testing.T) {
+    for _, n := range []int { 0, 1, 31, 32, 33, 100, 1100 } {
+        for _, m := range []int { 0, 1, 32, 65, 1100 } {
+            v := rangeVector(n)
+            v2 := v.Concat(rangeVector(m).Slice(0, m)).Append("a")
+            if v2.Len() != n + m + 1 {
+                t.Errorf("Vector.Len method result is %v; want %v", v2.Len(), n + m + 1)
+            }
+            for i := 0; i < n + m; i++ {
+                var x interface{} = i
+                if i >= n {
+                    x = i - n
+                }
+                if !reflect.DeepEqual(v2.Get(i), Some(x)) {
+                    t.Errorf("Vector.Get method result is %v; want %v", v2.Get(i), Some(x))
+                }
+            }
+            if !reflect.DeepEqual(v2.Get(n + m), Some("a")) {
+                t.Errorf("Vector.Get method result is %v; want %v", v2.Get(n + m), Some("a"))
+            }
+            if v.Len() != n {
+                t.Errorf("Vector.Len method result is %v; want %v", v.Len(), n)
+            }
+        }
+    }
+}
+
+func TestVectorConcatMethodConcatenatesSlicedVectors(t *testing.T) {
+    v := rangeVector(100)
+    v2 := v.Slice(10, 40).Concat(v.Slice(50, 90))
+    ys := make([]interface{}, 0, 70)
+    for i := 10; i < 40; i++ {
+        ys = append(ys, i)
+    }
+    for i := 50; i < 90; i++ {
+        ys = append(ys, i)
+    }
+    if !reflect.DeepEqual(ToSlice(v2), InterfaceSlice(ys)) {
+        t.Errorf("Vector.Concat method result is %v; want %v", v2, InterfaceSlice(ys))
+    }
+    if !reflect.DeepEqual(v.Get(40), Some(40)) {
+        t.Errorf("Vector.Get method result is %v; want %v", v.Get(40), Some(40))
+    }
+}
+
+func TestToVectorFunctionCreatesVectorFromList(t *testing.T) {
+    v := ToVector(Cons(1, Cons(2, Cons(3, Nil()))))
+    if !reflect.DeepEqual(ToList(v), Cons(1, Cons(2, Cons(3, Nil())))) {
+        t.Errorf("ToVector function result is %v; want %v", v, Cons(1, Cons(2, Cons(3, Nil()))))
+    }
+}
+
+func TestVectorMapMethodMapsVector(t *testing.T) {
+    v := rangeVector(1000).Map(inc)
+    xs := make([]interface{}, 1000)
+    for i := range xs {
+        xs[i] = i + 1
+    }
+    if !reflect.DeepEqual(ToSlice(FoldableOrElse(v, nil)), InterfaceSlice(xs)) {
+        t.Errorf("Vector.Map method result is %v; want %v", v, InterfaceSlice(xs))
+    }
+}
+
+func TestVectorBindMethodBindsVector(t *testing.T) {
+    v := rangeVector(3).Bind(func(x interface{}) Monad {
+            return VectorUnit(x).(*Vector).Append(x)
+    })
+    if !reflect.DeepEqual(ToSlice(FoldableOrElse(v, nil)), InterfaceSlice([]interface{} { 0, 0, 1, 1, 2, 2 })) {
+        t.Errorf("Vector.Bind method result is %v; want %v", v, InterfaceSlice([]interface{} { 0, 0, 1, 1, 2, 2 }))
+    }
+}
+
+func TestVectorFoldRightMethodFoldsVector(t *testing.T) {
+    x := rangeVector(100).Slice(1, 99).FoldRight(func(x, y interface{}) interface{} {
+            return Cons(x, ListOrElse(y, Nil()))
+    }, Nil())
+    if !reflect.DeepEqual(x, ToList(rangeVector(99).Slice(1, 99))) {
+        t.Errorf("Vector.FoldRight method result is %v; want %v", x, ToList(rangeVector(99).Slice(1, 99)))
+    }
+}
+
+func TestVectorZipMethodZipsVectors(t *testing.T) {
+    v := rangeVector(3).Zip(ToVector(InterfaceSlice([]interface{} { "a", "b" })), nil)
+    if !reflect.DeepEqual(ToSlice(FoldableOrElse(v, nil)), InterfaceSlice([]interface{} { NewPair(0, "a"), NewPair(1, "b") })) {
+        t.Errorf("Vector.Zip method result is %v; want %v", v, InterfaceSlice([]interface{} { NewPair(0, "a"), NewPair(1, "b") }))
+    }
+}
+
+func TestVectorUnzipMethodUnzipsVector(t *testing.T) {
+    v, v2 := ToVector(InterfaceSlice([]interface{} { NewPair(0, "a"), NewPair(1, "b") })).Unzip(nil)
+    if !reflect.DeepEqual(ToSlice(FoldableOrElse(v, nil)), InterfaceSlice([]interface{} { 0, 1 })) {
+        t.Errorf("Vector.Unzip method first result is %v; want %v", v, InterfaceSlice([]interface{} { 0, 1 }))
+    }
+    if !reflect.DeepEqual(ToSlice(FoldableOrElse(v2, nil)), InterfaceSlice([]interface{} { "a", "b" })) {
+        t.Errorf("Vector.Unzip method second result is %v; want %v", v2, InterfaceSlice([]interface{} { "a", "b" }))
+    }
+}
+
+func TestFindFunctionFindsElementInVector(t *testing.T) {
+    o := Find(func(x interface{}) bool {
+            return IntOrElse(x, 0) > 40
+    }, rangeVector(100))
+    if !reflect.DeepEqual(o, Some(41)) {
+        t.Errorf("Find function result is %v; want %v", o, Some(41))
+    }
+}
@@ -117,3 +117,72 @@ func (xs InterfacePairFunction) Zip(ys Zippable, fail Unzippable) Unzippable {
         return fail
     }
 }
+
+func (xs *Future) Zip(ys Zippable, fail Unzippable) Unzippable {
+    ys2, isOk := ys.(*Future)
+    if isOk {
+        fut := newFuture()
+        go func() {
+            xsDone, ysDone := xs.done, ys2.done
+            for xsDone != nil || ysDone != nil {
+                select {
+                case <-xsDone:
+                    if xs.result.IsLeft() {
+                        fut.complete(xs.result)
+                        return
+                    }
+                    xsDone = nil
+                case <-ysDone:
+                    if ys2.result.IsLeft() {
+                        fut.complete(ys2.result)
+                        return
+                    }
+                    ysDone = nil
+                case <-fut.done:
+                    return
+                }
+            }
+            fut.complete(Right(NewPair(xs.result.GetRight(), ys2.result.GetRight())))
+        }()
+        return fut
+    } else {
+        return fail
+    }
+}
+
+func (xs *Stream) Zip(ys Zippable, fail Unzippable) Unzippable {
+    ys2, isOk := ys.(*Stream)
+    if isOk {
+        if xs.isCons && ys2.isCons {
+            return ConsStream(NewPair(xs.head, ys2.head), func() *Stream {
+                    return StreamOrElse(xs.Tail().Zip(ys2.Tail(), fail), EmptyStream())
+            })
+        } else {
+            return EmptyStream()
+        }
+    } else {
+        return EmptyStream()
+    }
+}
+
+func (xs *Vector) Zip(ys Zippable, fail Unzippable) Unzippable {
+    ys2, isOk := ys.(*Vector)
+    if isOk {
+        zs := NewVector()
+        for i := 0; i < xs.length && i < ys2.length; i++ {
+            zs = zs.Append(NewPair(xs.Get(i).Get(), ys2.Get(i).Get()))
+        }
+        return zs
+    } else {
+        return NewVector()
+    }
+}
+
+func (xs *FingerTree) Zip(ys Zippable, fail Unzippable) Unzippable {
+    ys2, isOk := ys.(*FingerTree)
+    if isOk {
+        return xs.ZipMeasured(ys2, xs.m, xs.measure)
+    } else {
+        return xs.withRoot(nil)
+    }
+}